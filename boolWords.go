@@ -0,0 +1,79 @@
+package value
+
+import (
+	"errors"
+	"strings"
+)
+
+// BoolWords is a vocabulary of words recognized as true and false.
+// Words are compared case-insensitively.
+type BoolWords struct {
+	True  []string
+	False []string
+}
+
+// Bool word presets
+var (
+	BoolWordsEnglish = BoolWords{
+		True:  []string{"true", "t", "yes", "y", "on", "enabled", "enable", "1"},
+		False: []string{"false", "f", "no", "n", "off", "disabled", "disable", "0"},
+	}
+	BoolWordsLithuanian = BoolWords{
+		True:  []string{"taip", "tiesa", "įjungta"},
+		False: []string{"ne", "netiesa", "išjungta"},
+	}
+	BoolWordsGerman = BoolWords{
+		True:  []string{"ja", "j", "wahr", "an", "ein"},
+		False: []string{"nein", "falsch", "aus"},
+	}
+	BoolWordsFrench = BoolWords{
+		True:  []string{"oui", "o", "vrai"},
+		False: []string{"non", "faux"},
+	}
+	BoolWordsSpanish = BoolWords{
+		True:  []string{"sí", "si", "s", "verdadero"},
+		False: []string{"falso"},
+	}
+)
+
+// BoolVocabulary is the set of words recognized by ToBoolE, ToBoolSliceE and Value.Bool.
+// Combine presets with Merge, for example BoolWordsEnglish.Merge(BoolWordsGerman).
+// Use Converter to apply other vocabulary per call.
+var BoolVocabulary = BoolWordsEnglish
+
+// ErrUnknownBoolWord is returned when string is neither in BoolVocabulary.True nor in BoolVocabulary.False
+var ErrUnknownBoolWord = errors.New("unknown bool word")
+
+// Merge returns vocabulary containing words of w and others
+func (w BoolWords) Merge(others ...BoolWords) BoolWords {
+	merged := BoolWords{
+		True:  append([]string{}, w.True...),
+		False: append([]string{}, w.False...),
+	}
+	for _, o := range others {
+		merged.True = append(merged.True, o.True...)
+		merged.False = append(merged.False, o.False...)
+	}
+	return merged
+}
+
+// Parse looks up s in vocabulary, true words take precedence over false words
+func (w BoolWords) Parse(s string) (bool, error) {
+	s = strings.TrimSpace(s)
+	if containsFold(w.True, s) {
+		return true, nil
+	}
+	if containsFold(w.False, s) {
+		return false, nil
+	}
+	return false, ErrUnknownBoolWord
+}
+
+func containsFold(words []string, s string) bool {
+	for _, word := range words {
+		if strings.EqualFold(word, s) {
+			return true
+		}
+	}
+	return false
+}
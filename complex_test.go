@@ -0,0 +1,62 @@
+package value
+
+import "testing"
+
+func TestToComplex128E(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        interface{}
+		defaultValue []complex128
+		want         complex128
+		err          bool
+	}{
+		{"complex", 1 + 2i, nil, 1 + 2i, false},
+		{"string", "1+2i", nil, 1 + 2i, false},
+		{"pair", []float64{1, 2}, nil, 1 + 2i, false},
+		{"json pair", "[1, 2]", nil, 1 + 2i, false},
+		{"float", 1.5, nil, 1.5, false},
+		{"nil default", nil, []complex128{5}, 5, false},
+		{"invalid default", "x", []complex128{5}, 5, true},
+		{"bad pair", []int{1}, nil, 0, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ToComplex128E(test.input, test.defaultValue...)
+			if (err != nil) != test.err {
+				t.Fatalf("ToComplex128E(%v) error = %v, want error %v", test.input, err, test.err)
+			}
+			if got != test.want {
+				t.Errorf("ToComplex128E(%v) = %v, want %v", test.input, got, test.want)
+			}
+		})
+	}
+}
+
+func TestToComplex64E(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        interface{}
+		defaultValue []complex64
+		want         complex64
+		err          bool
+	}{
+		{"complex128", 1 + 2i, nil, 1 + 2i, false},
+		{"string", "1.5-2i", nil, 1.5 - 2i, false},
+		{"nil", nil, nil, 0, false},
+		{"nil default", nil, []complex64{5}, 5, false},
+		{"invalid default", "x", []complex64{5 + 1i}, 5 + 1i, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ToComplex64E(test.input, test.defaultValue...)
+			if (err != nil) != test.err {
+				t.Fatalf("ToComplex64E(%v) error = %v, want error %v", test.input, err, test.err)
+			}
+			if got != test.want {
+				t.Errorf("ToComplex64E(%v) = %v, want %v", test.input, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package value
+
+import (
+	"flag"
+	"reflect"
+	"strings"
+)
+
+// Source is a layer of configuration values addressed by dotted paths like "db.host"
+type Source interface {
+	// Get returns value at path
+	Get(path string) (Value, bool)
+	// Keys returns paths of all leaf values
+	Keys() []string
+}
+
+// MapSource is Source over nested map
+type MapSource struct {
+	m map[string]interface{}
+}
+
+// NewMapSource returns Source over i, anything accepted by ToMapE
+func NewMapSource(i interface{}) *MapSource {
+	return &MapSource{m: ToMap(i)}
+}
+
+// NewEnvSource returns Source over environment variables with prefix, see FromEnv
+func NewEnvSource(prefix string, options ...EnvOptions) *MapSource {
+	return &MapSource{m: FromEnv(prefix, options...)}
+}
+
+// Get returns value at path
+func (s *MapSource) Get(path string) (Value, bool) {
+	v, err := ToMapGetE(s.m, path)
+	return v, err == nil
+}
+
+// Keys returns paths of all leaf values ordered by SortKeys
+func (s *MapSource) Keys() []string {
+	keys := leafKeys("", s.m, []string{})
+	SortKeys(keys)
+	return keys
+}
+
+// FlagSource is Source over flags which were set, flag names with dots are nested like "db.host".
+// Values of flags implementing flag.Getter are typed, other values are strings.
+type FlagSource struct {
+	fs *flag.FlagSet
+}
+
+// NewFlagSource returns Source over flags of fs which were set, flag.CommandLine when fs is nil
+func NewFlagSource(fs *flag.FlagSet) *FlagSource {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+	return &FlagSource{fs: fs}
+}
+
+func (s *FlagSource) source() *MapSource {
+	m := map[string]interface{}{}
+	s.fs.Visit(func(f *flag.Flag) {
+		var v interface{} = f.Value.String()
+		if g, ok := f.Value.(flag.Getter); ok {
+			v = g.Get()
+		}
+		path := strings.Split(f.Name, ".")
+		parent := m
+		for _, part := range path[:len(path)-1] {
+			child, ok := parent[part].(map[string]interface{})
+			if !ok {
+				child = map[string]interface{}{}
+				parent[part] = child
+			}
+			parent = child
+		}
+		parent[path[len(path)-1]] = v
+	})
+	return &MapSource{m: m}
+}
+
+// Get returns value of flag at path
+func (s *FlagSource) Get(path string) (Value, bool) {
+	return s.source().Get(path)
+}
+
+// Keys returns names of flags which were set
+func (s *FlagSource) Keys() []string {
+	return s.source().Keys()
+}
+
+// Layer is named Source of Layered
+type Layer struct {
+	Name string
+	Source
+}
+
+// Layered resolves paths through layers, later layers have higher priority
+type Layered struct {
+	layers []Layer
+}
+
+// NewLayered returns Layered over layers ordered from the lowest priority, for example defaults, file, env, flags
+func NewLayered(layers ...Layer) *Layered {
+	return &Layered{layers: layers}
+}
+
+// Add adds layer with the highest priority
+func (l *Layered) Add(name string, s Source) *Layered {
+	l.layers = append(l.layers, Layer{Name: name, Source: s})
+	return l
+}
+
+// Get returns value of the highest priority layer containing path, nil Value when none does.
+// Maps found in several layers are merged, higher layers override keys of lower ones.
+func (l *Layered) Get(path string) Value {
+	v, _ := l.Lookup(path)
+	return v
+}
+
+// Lookup returns value like Get and whether any layer contains path
+func (l *Layered) Lookup(path string) (Value, bool) {
+	var maps []map[string]interface{}
+	for j := len(l.layers) - 1; j >= 0; j-- {
+		v, ok := l.layers[j].Get(path)
+		if !ok {
+			continue
+		}
+		if !isMap(v) {
+			if len(maps) > 0 {
+				break
+			}
+			return v, true
+		}
+		maps = append(maps, ToMap(v))
+	}
+	if len(maps) == 0 {
+		return New(nil), false
+	}
+	merged := map[string]interface{}{}
+	for j := len(maps) - 1; j >= 0; j-- {
+		mergeMaps(merged, maps[j])
+	}
+	return New(merged), true
+}
+
+// Keys returns paths of leaf values of all layers ordered by SortKeys
+func (l *Layered) Keys() []string {
+	return sortedKeys(l.Origins())
+}
+
+// Origin returns name of the layer supplying path
+func (l *Layered) Origin(path string) (string, bool) {
+	for j := len(l.layers) - 1; j >= 0; j-- {
+		if v, ok := l.layers[j].Get(path); ok && !isMap(v) {
+			return l.layers[j].Name, true
+		}
+	}
+	return "", false
+}
+
+// Origins returns name of the layer supplying each leaf path
+func (l *Layered) Origins() map[string]string {
+	origins := map[string]string{}
+	for _, layer := range l.layers {
+		for _, k := range layer.Keys() {
+			origins[k] = layer.Name
+		}
+	}
+	return origins
+}
+
+// isMap reports whether i is a map or *OrderedMap
+func isMap(i interface{}) bool {
+	if v, ok := i.(Value); ok {
+		i = v.value
+	}
+	i = underlying(i)
+	if _, ok := i.(*OrderedMap); ok {
+		return true
+	}
+	return i != nil && reflect.TypeOf(indirect(i)).Kind() == reflect.Map
+}
+
+// leafKeys appends paths of leaf values of nested map m to keys
+func leafKeys(prefix string, m map[string]interface{}, keys []string) []string {
+	for k, v := range m {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		if isMap(v) {
+			keys = leafKeys(path, ToMap(v), keys)
+			continue
+		}
+		keys = append(keys, path)
+	}
+	return keys
+}
+
+// mergeMaps copies src into dst, nested maps are merged
+func mergeMaps(dst, src map[string]interface{}) {
+	for k, v := range src {
+		if isMap(v) {
+			child, ok := dst[k].(map[string]interface{})
+			if !ok {
+				child = map[string]interface{}{}
+				dst[k] = child
+			}
+			mergeMaps(child, ToMap(v))
+			continue
+		}
+		dst[k] = v
+	}
+}
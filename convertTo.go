@@ -7,6 +7,8 @@
 package value
 
 import (
+	"database/sql/driver"
+	"encoding"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -15,7 +17,6 @@ import (
 	"strconv"
 	"strings"
 	"time"
-	"unicode"
 )
 
 var errNegativeNotAllowed = errors.New("unable to cast negative value")
@@ -42,8 +43,8 @@ func indirect(a interface{}) interface{} {
 // From html/template/content.go
 // Copyright 2011 The Go Authors. All rights reserved.
 // indirectToStringerOrError returns the value, after dereferencing as many times
-// as necessary to reach the base type (or nil) or an implementation of fmt.Stringer
-// or error,
+// as necessary to reach the base type (or nil) or an implementation of fmt.Stringer,
+// encoding.TextMarshaler or error,
 func indirectToStringerOrError(a interface{}) interface{} {
 	if a == nil {
 		return nil
@@ -51,22 +52,75 @@ func indirectToStringerOrError(a interface{}) interface{} {
 
 	var errorType = reflect.TypeOf((*error)(nil)).Elem()
 	var fmtStringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
 
 	v := reflect.ValueOf(a)
-	for !v.Type().Implements(fmtStringerType) && !v.Type().Implements(errorType) && v.Kind() == reflect.Ptr && !v.IsNil() {
+	for !v.Type().Implements(fmtStringerType) && !v.Type().Implements(textMarshalerType) && !v.Type().Implements(errorType) && v.Kind() == reflect.Ptr && !v.IsNil() {
 		v = v.Elem()
 	}
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil
+	}
 	return v.Interface()
 }
 
+// underlying returns underlying value of database/sql/driver.Valuer implementations
+// like sql.NullString, sql.NullInt64, sql.NullTime or Nullable (null values become nil)
+// and json.Number as int64, uint64 or float64.
+func underlying(i interface{}) interface{} {
+	if n, ok := i.(json.Number); ok {
+		return fromJSONNumber(n)
+	}
+	valuer, ok := i.(driver.Valuer)
+	if !ok {
+		return i
+	}
+	if v := reflect.ValueOf(i); v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil
+	}
+	v, err := valuer.Value()
+	if err != nil {
+		return i
+	}
+	if b, ok := v.([]byte); ok {
+		return string(b)
+	}
+	return v
+}
+
+// fromJSONNumber returns n as int64, uint64 or float64, whichever holds it without loss, or as string if not a number
+func fromJSONNumber(n json.Number) interface{} {
+	if v, err := n.Int64(); err == nil {
+		return v
+	}
+	if v, err := strconv.ParseUint(string(n), 10, 64); err == nil {
+		return v
+	}
+	if v, err := n.Float64(); err == nil {
+		return v
+	}
+	return string(n)
+}
+
 // ToStringE casts an interface to a string type.
+// encoding.TextMarshaler implementations are preferred over fmt.Stringer.
+// Complex numbers are formatted as their real part, non zero imaginary part is an error.
 func ToStringE(i interface{}, defaultValue ...string) (value string, err error) {
+	return DefaultConverter().ToStringE(i, defaultValue...)
+}
+
+// ToStringE casts an interface to a string type using policies of c.
+func (c Converter) ToStringE(i interface{}, defaultValue ...string) (value string, err error) {
 	if v, ok := i.([]uint8); ok {
 		i = string(v)
 	}
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	if v, ok := i.(json.Number); ok {
+		i = string(v)
+	}
+	i = underlying(i)
 	i = indirectToStringerOrError(i)
 	value = ""
 	err = nil
@@ -80,9 +134,37 @@ func ToStringE(i interface{}, defaultValue ...string) (value string, err error)
 	case bool:
 		value = strconv.FormatBool(s)
 	case float64:
-		value = strconv.FormatFloat(s, 'f', -1, 64)
+		if v, _, e := c.specialFloat(s); e == nil {
+			value = strconv.FormatFloat(v, 'f', -1, 64)
+		} else {
+			err = e
+		}
 	case float32:
-		value = strconv.FormatFloat(float64(s), 'f', -1, 32)
+		if v, _, e := c.specialFloat(float64(s)); e == nil {
+			value = strconv.FormatFloat(v, 'f', -1, 32)
+		} else {
+			err = e
+		}
+	case complex128:
+		v, e := complexReal(s)
+		if e == nil {
+			v, _, e = c.specialFloat(v)
+		}
+		if e == nil {
+			value = strconv.FormatFloat(v, 'f', -1, 64)
+		} else {
+			err = e
+		}
+	case complex64:
+		v, e := complexReal(complex128(s))
+		if e == nil {
+			v, _, e = c.specialFloat(v)
+		}
+		if e == nil {
+			value = strconv.FormatFloat(v, 'f', -1, 32)
+		} else {
+			err = e
+		}
 	case int:
 		value = strconv.Itoa(s)
 	case int64:
@@ -94,15 +176,15 @@ func ToStringE(i interface{}, defaultValue ...string) (value string, err error)
 	case int8:
 		value = strconv.FormatInt(int64(s), 10)
 	case uint:
-		value = strconv.FormatInt(int64(s), 10)
+		value = strconv.FormatUint(uint64(s), 10)
 	case uint64:
-		value = strconv.FormatInt(int64(s), 10)
+		value = strconv.FormatUint(uint64(s), 10)
 	case uint32:
-		value = strconv.FormatInt(int64(s), 10)
+		value = strconv.FormatUint(uint64(s), 10)
 	case uint16:
-		value = strconv.FormatInt(int64(s), 10)
+		value = strconv.FormatUint(uint64(s), 10)
 	case uint8:
-		value = strconv.FormatInt(int64(s), 10)
+		value = strconv.FormatUint(uint64(s), 10)
 	case []byte:
 		value = string(s)
 	case template.HTML:
@@ -116,6 +198,13 @@ func ToStringE(i interface{}, defaultValue ...string) (value string, err error)
 	case template.HTMLAttr:
 		value = string(s)
 	case nil:
+	case encoding.TextMarshaler:
+		b, e := s.MarshalText()
+		if e == nil {
+			value = string(b)
+		} else {
+			err = e
+		}
 	case fmt.Stringer:
 		value = s.String()
 	case error:
@@ -131,13 +220,21 @@ func ToString(i interface{}, defaultValue ...string) string {
 }
 
 // ToBoolE casts an interface to a bool type.
+// Strings are looked up in BoolVocabulary, unrecognized words return ErrUnknownBoolWord.
+// If boolTrue is provided, value is true when it matches any of boolTrue (case-insensitively) and false otherwise.
 func ToBoolE(i interface{}, boolTrue ...string) (value bool, err error) {
+	return DefaultConverter().ToBoolE(i, boolTrue...)
+}
+
+// ToBoolE casts an interface to a bool type using policies of c.
+func (c Converter) ToBoolE(i interface{}, boolTrue ...string) (value bool, err error) {
 	if v, ok := i.([]uint8); ok {
 		i = string(v)
 	}
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
 	value = false
 	err = nil
 	i = indirect(i)
@@ -148,11 +245,7 @@ func ToBoolE(i interface{}, boolTrue ...string) (value bool, err error) {
 	case nil:
 	case float32, float64, uint, uint8, uint16, uint32, uint64, int, int8, int16, int32, int64:
 		if len(boolTrue) > 0 {
-			if ToString(b) == boolTrue[0] {
-				value = true
-				return
-			}
-			value = false
+			value = containsFold(boolTrue, ToString(b))
 			return
 		}
 
@@ -161,14 +254,15 @@ func ToBoolE(i interface{}, boolTrue ...string) (value bool, err error) {
 		}
 	case string:
 		if len(boolTrue) > 0 {
-			if b == boolTrue[0] {
-				value = true
-				return
-			}
-			value = false
+			value = containsFold(boolTrue, strings.TrimSpace(b))
 			return
 		}
-		value, err = strconv.ParseBool(b)
+		v, e := c.boolVocabulary().Parse(b)
+		if e == nil {
+			value = v
+		} else {
+			err = fmt.Errorf("unable to cast %#v of type %T to bool: %w", i, i, e)
+		}
 	default:
 		err = fmt.Errorf("unable to cast %#v of type %T to bool", i, i)
 	}
@@ -181,12 +275,18 @@ func ToBool(i interface{}, boolTrue ...string) bool {
 
 // ToFloat64E casts an interface to a float64 type.
 func ToFloat64E(i interface{}, defaultValue ...float64) (value float64, err error) {
+	return DefaultConverter().ToFloat64E(i, defaultValue...)
+}
+
+// ToFloat64E casts an interface to a float64 type using policies of c.
+func (c Converter) ToFloat64E(i interface{}, defaultValue ...float64) (value float64, err error) {
 	if v, ok := i.([]uint8); ok {
 		i = string(v)
 	}
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
 	i = indirect(i)
 	value = float64(0)
 	err = nil
@@ -196,9 +296,29 @@ func ToFloat64E(i interface{}, defaultValue ...float64) (value float64, err erro
 
 	switch s := i.(type) {
 	case float64:
-		value = s
+		if v, _, e := c.specialFloat(s); e == nil {
+			value = v
+		} else {
+			err = e
+		}
 	case float32:
-		value = float64(s)
+		if v, _, e := c.specialFloat(float64(s)); e == nil {
+			value = v
+		} else {
+			err = e
+		}
+	case complex128:
+		if v, e := complexReal(s); e == nil {
+			value = v
+		} else {
+			err = e
+		}
+	case complex64:
+		if v, e := complexReal(complex128(s)); e == nil {
+			value = v
+		} else {
+			err = e
+		}
 	case int:
 		value = float64(s)
 	case int64:
@@ -221,10 +341,13 @@ func ToFloat64E(i interface{}, defaultValue ...float64) (value float64, err erro
 		value = float64(s)
 	case string:
 		v, e := strconv.ParseFloat(s, 64)
+		if e == nil {
+			v, e = c.specialFloatString(v)
+		}
 		if e == nil {
 			value = v
 		} else {
-			err = fmt.Errorf("unable to cast %#v of type %T to float64", i, i)
+			err = fmt.Errorf("unable to cast %#v of type %T to float64: %w", i, i, e)
 		}
 	case bool:
 		if s {
@@ -242,12 +365,18 @@ func ToFloat64(i interface{}, defaultValue ...float64) float64 {
 
 // ToFloat32E casts an interface to a float32 type.
 func ToFloat32E(i interface{}, defaultValue ...float32) (value float32, err error) {
+	return DefaultConverter().ToFloat32E(i, defaultValue...)
+}
+
+// ToFloat32E casts an interface to a float32 type using policies of c.
+func (c Converter) ToFloat32E(i interface{}, defaultValue ...float32) (value float32, err error) {
 	if v, ok := i.([]uint8); ok {
 		i = string(v)
 	}
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
 	i = indirect(i)
 	value = float32(0)
 	err = nil
@@ -257,9 +386,29 @@ func ToFloat32E(i interface{}, defaultValue ...float32) (value float32, err erro
 
 	switch s := i.(type) {
 	case float64:
-		value = float32(s)
+		if v, _, e := c.specialFloat(s); e == nil {
+			value = float32(v)
+		} else {
+			err = e
+		}
 	case float32:
-		value = s
+		if v, _, e := c.specialFloat(float64(s)); e == nil {
+			value = float32(v)
+		} else {
+			err = e
+		}
+	case complex128:
+		if v, e := complexReal(s); e == nil {
+			value = float32(v)
+		} else {
+			err = e
+		}
+	case complex64:
+		if v, e := complexReal(complex128(s)); e == nil {
+			value = float32(v)
+		} else {
+			err = e
+		}
 	case int:
 		value = float32(s)
 	case int64:
@@ -282,10 +431,13 @@ func ToFloat32E(i interface{}, defaultValue ...float32) (value float32, err erro
 		value = float32(s)
 	case string:
 		v, e := strconv.ParseFloat(s, 32)
+		if e == nil {
+			v, e = c.specialFloatString(v)
+		}
 		if e == nil {
 			value = float32(v)
 		} else {
-			err = fmt.Errorf("unable to cast %#v of type %T to float32", i, i)
+			err = fmt.Errorf("unable to cast %#v of type %T to float32: %w", i, i, e)
 		}
 	case bool:
 		if s {
@@ -301,14 +453,140 @@ func ToFloat32(i interface{}, defaultValue ...float32) float32 {
 	return v
 }
 
+// ToComplex128E casts an interface to a complex128 type.
+// Strings are parsed as "3+4i" or as JSON pair "[3, 4]", slices and arrays of length 2 are [real, imaginary] pairs.
+func ToComplex128E(i interface{}, defaultValue ...complex128) (value complex128, err error) {
+	if v, ok := i.([]uint8); ok {
+		i = string(v)
+	}
+	if v, ok := i.(Value); ok {
+		i = v.value
+	}
+	i = underlying(i)
+	i = indirect(i)
+	value = complex128(0)
+	err = nil
+	if len(defaultValue) > 0 {
+		value = defaultValue[0]
+	}
+
+	switch s := i.(type) {
+	case complex128:
+		value = s
+	case complex64:
+		value = complex128(s)
+	case string:
+		s = strings.TrimSpace(s)
+		if strings.HasPrefix(s, "[") {
+			var pair []interface{}
+			if e := json.Unmarshal([]uint8(s), &pair); e != nil {
+				err = fmt.Errorf("unable to cast %#v of type %T to complex128: %w", i, i, e)
+				return
+			}
+			v, e := complexFromPair(pair)
+			if e != nil {
+				err = fmt.Errorf("unable to cast %#v of type %T to complex128: %w", i, i, e)
+				return
+			}
+			value = v
+			return
+		}
+		v, e := strconv.ParseComplex(s, 128)
+		if e == nil {
+			value = v
+		} else {
+			err = fmt.Errorf("unable to cast %#v of type %T to complex128: %w", i, i, e)
+		}
+	case nil:
+	default:
+		kind := reflect.TypeOf(i).Kind()
+		if kind == reflect.Slice || kind == reflect.Array {
+			v, e := complexFromPair(i)
+			if e == nil {
+				value = v
+			} else {
+				err = fmt.Errorf("unable to cast %#v of type %T to complex128: %w", i, i, e)
+			}
+			return
+		}
+		f, e := ToFloat64E(i)
+		if e == nil {
+			value = complex(f, 0)
+		} else {
+			err = fmt.Errorf("unable to cast %#v of type %T to complex128", i, i)
+		}
+	}
+	return
+}
+func ToComplex128(i interface{}, defaultValue ...complex128) complex128 {
+	v, _ := ToComplex128E(i, defaultValue...)
+	return v
+}
+
+// ToComplex64E casts an interface to a complex64 type.
+func ToComplex64E(i interface{}, defaultValue ...complex64) (value complex64, err error) {
+	value = complex64(0)
+	err = nil
+	if len(defaultValue) > 0 {
+		value = defaultValue[0]
+	}
+
+	def := []complex128{}
+	if len(defaultValue) > 0 {
+		def = append(def, complex128(defaultValue[0]))
+	}
+	v, e := ToComplex128E(i, def...)
+	if e != nil {
+		err = fmt.Errorf("unable to cast %#v of type %T to complex64: %w", i, i, e)
+		return
+	}
+	value = complex64(v)
+	return
+}
+func ToComplex64(i interface{}, defaultValue ...complex64) complex64 {
+	v, _ := ToComplex64E(i, defaultValue...)
+	return v
+}
+
+// complexFromPair casts [real, imaginary] slice or array to complex128
+func complexFromPair(i interface{}) (complex128, error) {
+	s := reflect.ValueOf(i)
+	if s.Len() != 2 {
+		return 0, fmt.Errorf("complex pair must have 2 elements, got %d", s.Len())
+	}
+	re, err := ToFloat64E(s.Index(0).Interface())
+	if err != nil {
+		return 0, err
+	}
+	im, err := ToFloat64E(s.Index(1).Interface())
+	if err != nil {
+		return 0, err
+	}
+	return complex(re, im), nil
+}
+
+// complexReal returns real part of c, if imaginary part is zero
+func complexReal(c complex128) (float64, error) {
+	if imag(c) != 0 {
+		return 0, fmt.Errorf("unable to cast complex %v with non zero imaginary part to real number", c)
+	}
+	return real(c), nil
+}
+
 // ToInt64E casts an interface to an int64 type.
 func ToInt64E(i interface{}, defaultValue ...int64) (value int64, err error) {
+	return DefaultConverter().ToInt64E(i, defaultValue...)
+}
+
+// ToInt64E casts an interface to an int64 type using policies of c.
+func (c Converter) ToInt64E(i interface{}, defaultValue ...int64) (value int64, err error) {
 	if v, ok := i.([]uint8); ok {
 		i = string(v)
 	}
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
 	i = indirect(i)
 	value = int64(0)
 	err = nil
@@ -317,36 +595,33 @@ func ToInt64E(i interface{}, defaultValue ...int64) (value int64, err error) {
 	}
 
 	switch s := i.(type) {
-	case int:
-		value = int64(s)
-	case int64:
-		value = s
-	case int32:
-		value = int64(s)
-	case int16:
-		value = int64(s)
-	case int8:
-		value = int64(s)
-	case uint:
-		value = int64(s)
-	case uint64:
-		value = int64(s)
-	case uint32:
-		value = int64(s)
-	case uint16:
-		value = int64(s)
-	case uint8:
-		value = int64(s)
+	case int, int64, int32, int16, int8, uint, uint64, uint32, uint16, uint8:
+		v, e := intToInt64(s, 64)
+		if e == nil {
+			value = v
+		} else {
+			err = e
+		}
 	case float64:
-		value = int64(s)
+		v, e := c.floatToInt64(s, 64)
+		if e == nil {
+			value = v
+		} else {
+			err = e
+		}
 	case float32:
-		value = int64(s)
+		v, e := c.floatToInt64(float64(s), 64)
+		if e == nil {
+			value = v
+		} else {
+			err = e
+		}
 	case string:
-		v, e := strconv.ParseInt(s, 0, 0)
+		v, e := c.parseIntString(s, 64)
 		if e == nil {
 			value = v
 		} else {
-			err = fmt.Errorf("unable to cast %#v of type %T to int64", i, i)
+			err = fmt.Errorf("unable to cast %#v of type %T to int64: %w", i, i, e)
 		}
 	case bool:
 		if s {
@@ -365,12 +640,18 @@ func ToInt64(i interface{}, defaultValue ...int64) int64 {
 
 // ToInt32E casts an interface to an int32 type.
 func ToInt32E(i interface{}, defaultValue ...int32) (value int32, err error) {
+	return DefaultConverter().ToInt32E(i, defaultValue...)
+}
+
+// ToInt32E casts an interface to an int32 type using policies of c.
+func (c Converter) ToInt32E(i interface{}, defaultValue ...int32) (value int32, err error) {
 	if v, ok := i.([]uint8); ok {
 		i = string(v)
 	}
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
 	i = indirect(i)
 	value = int32(0)
 	err = nil
@@ -379,36 +660,33 @@ func ToInt32E(i interface{}, defaultValue ...int32) (value int32, err error) {
 	}
 
 	switch s := i.(type) {
-	case int:
-		value = int32(s)
-	case int64:
-		value = int32(s)
-	case int32:
-		value = s
-	case int16:
-		value = int32(s)
-	case int8:
-		value = int32(s)
-	case uint:
-		value = int32(s)
-	case uint64:
-		value = int32(s)
-	case uint32:
-		value = int32(s)
-	case uint16:
-		value = int32(s)
-	case uint8:
-		value = int32(s)
+	case int, int64, int32, int16, int8, uint, uint64, uint32, uint16, uint8:
+		v, e := intToInt64(s, 32)
+		if e == nil {
+			value = int32(v)
+		} else {
+			err = e
+		}
 	case float64:
-		value = int32(s)
+		v, e := c.floatToInt64(s, 32)
+		if e == nil {
+			value = int32(v)
+		} else {
+			err = e
+		}
 	case float32:
-		value = int32(s)
+		v, e := c.floatToInt64(float64(s), 32)
+		if e == nil {
+			value = int32(v)
+		} else {
+			err = e
+		}
 	case string:
-		v, e := strconv.ParseInt(s, 0, 0)
+		v, e := c.parseIntString(s, 32)
 		if e == nil {
 			value = int32(v)
 		} else {
-			err = fmt.Errorf("unable to cast %#v of type %T to int32", i, i)
+			err = fmt.Errorf("unable to cast %#v of type %T to int32: %w", i, i, e)
 		}
 	case bool:
 		if s {
@@ -427,12 +705,18 @@ func ToInt32(i interface{}, defaultValue ...int32) int32 {
 
 // ToInt16E casts an interface to an int16 type.
 func ToInt16E(i interface{}, defaultValue ...int16) (value int16, err error) {
+	return DefaultConverter().ToInt16E(i, defaultValue...)
+}
+
+// ToInt16E casts an interface to an int16 type using policies of c.
+func (c Converter) ToInt16E(i interface{}, defaultValue ...int16) (value int16, err error) {
 	if v, ok := i.([]uint8); ok {
 		i = string(v)
 	}
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
 	i = indirect(i)
 	value = int16(0)
 	err = nil
@@ -441,36 +725,33 @@ func ToInt16E(i interface{}, defaultValue ...int16) (value int16, err error) {
 	}
 
 	switch s := i.(type) {
-	case int:
-		value = int16(s)
-	case int64:
-		value = int16(s)
-	case int32:
-		value = int16(s)
-	case int16:
-		value = s
-	case int8:
-		value = int16(s)
-	case uint:
-		value = int16(s)
-	case uint64:
-		value = int16(s)
-	case uint32:
-		value = int16(s)
-	case uint16:
-		value = int16(s)
-	case uint8:
-		value = int16(s)
+	case int, int64, int32, int16, int8, uint, uint64, uint32, uint16, uint8:
+		v, e := intToInt64(s, 16)
+		if e == nil {
+			value = int16(v)
+		} else {
+			err = e
+		}
 	case float64:
-		value = int16(s)
+		v, e := c.floatToInt64(s, 16)
+		if e == nil {
+			value = int16(v)
+		} else {
+			err = e
+		}
 	case float32:
-		value = int16(s)
+		v, e := c.floatToInt64(float64(s), 16)
+		if e == nil {
+			value = int16(v)
+		} else {
+			err = e
+		}
 	case string:
-		v, e := strconv.ParseInt(s, 0, 0)
+		v, e := c.parseIntString(s, 16)
 		if e == nil {
 			value = int16(v)
 		} else {
-			err = fmt.Errorf("unable to cast %#v of type %T to int16", i, i)
+			err = fmt.Errorf("unable to cast %#v of type %T to int16: %w", i, i, e)
 		}
 	case bool:
 		if s {
@@ -489,12 +770,18 @@ func ToInt16(i interface{}, defaultValue ...int16) int16 {
 
 // ToInt8E casts an interface to an int8 type.
 func ToInt8E(i interface{}, defaultValue ...int8) (value int8, err error) {
+	return DefaultConverter().ToInt8E(i, defaultValue...)
+}
+
+// ToInt8E casts an interface to an int8 type using policies of c.
+func (c Converter) ToInt8E(i interface{}, defaultValue ...int8) (value int8, err error) {
 	if v, ok := i.([]uint8); ok {
 		i = string(v)
 	}
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
 	i = indirect(i)
 	value = int8(0)
 	err = nil
@@ -503,36 +790,33 @@ func ToInt8E(i interface{}, defaultValue ...int8) (value int8, err error) {
 	}
 
 	switch s := i.(type) {
-	case int:
-		value = int8(s)
-	case int64:
-		value = int8(s)
-	case int32:
-		value = int8(s)
-	case int16:
-		value = int8(s)
-	case int8:
-		value = s
-	case uint:
-		value = int8(s)
-	case uint64:
-		value = int8(s)
-	case uint32:
-		value = int8(s)
-	case uint16:
-		value = int8(s)
-	case uint8:
-		value = int8(s)
+	case int, int64, int32, int16, int8, uint, uint64, uint32, uint16, uint8:
+		v, e := intToInt64(s, 8)
+		if e == nil {
+			value = int8(v)
+		} else {
+			err = e
+		}
 	case float64:
-		value = int8(s)
+		v, e := c.floatToInt64(s, 8)
+		if e == nil {
+			value = int8(v)
+		} else {
+			err = e
+		}
 	case float32:
-		value = int8(s)
+		v, e := c.floatToInt64(float64(s), 8)
+		if e == nil {
+			value = int8(v)
+		} else {
+			err = e
+		}
 	case string:
-		v, e := strconv.ParseInt(s, 0, 0)
+		v, e := c.parseIntString(s, 8)
 		if e == nil {
 			value = int8(v)
 		} else {
-			err = fmt.Errorf("unable to cast %#v of type %T to int8", i, i)
+			err = fmt.Errorf("unable to cast %#v of type %T to int8: %w", i, i, e)
 		}
 	case bool:
 		if s {
@@ -551,12 +835,18 @@ func ToInt8(i interface{}, defaultValue ...int8) int8 {
 
 // ToIntE casts an interface to an int type.
 func ToIntE(i interface{}, defaultValue ...int) (value int, err error) {
+	return DefaultConverter().ToIntE(i, defaultValue...)
+}
+
+// ToIntE casts an interface to an int type using policies of c.
+func (c Converter) ToIntE(i interface{}, defaultValue ...int) (value int, err error) {
 	if v, ok := i.([]uint8); ok {
 		i = string(v)
 	}
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
 	i = indirect(i)
 	value = int(0)
 	err = nil
@@ -565,36 +855,33 @@ func ToIntE(i interface{}, defaultValue ...int) (value int, err error) {
 	}
 
 	switch s := i.(type) {
-	case int:
-		value = s
-	case int64:
-		value = int(s)
-	case int32:
-		value = int(s)
-	case int16:
-		value = int(s)
-	case int8:
-		value = int(s)
-	case uint:
-		value = int(s)
-	case uint64:
-		value = int(s)
-	case uint32:
-		value = int(s)
-	case uint16:
-		value = int(s)
-	case uint8:
-		value = int(s)
+	case int, int64, int32, int16, int8, uint, uint64, uint32, uint16, uint8:
+		v, e := intToInt64(s, 0)
+		if e == nil {
+			value = int(v)
+		} else {
+			err = e
+		}
 	case float64:
-		value = int(s)
+		v, e := c.floatToInt64(s, 0)
+		if e == nil {
+			value = int(v)
+		} else {
+			err = e
+		}
 	case float32:
-		value = int(s)
+		v, e := c.floatToInt64(float64(s), 0)
+		if e == nil {
+			value = int(v)
+		} else {
+			err = e
+		}
 	case string:
-		v, e := strconv.ParseInt(s, 0, 0)
+		v, e := c.parseIntString(s, 0)
 		if e == nil {
 			value = int(v)
 		} else {
-			err = fmt.Errorf("unable to cast %#v of type %T to int", i, i)
+			err = fmt.Errorf("unable to cast %#v of type %T to int: %w", i, i, e)
 		}
 	case bool:
 		if s {
@@ -613,12 +900,18 @@ func ToInt(i interface{}, defaultValue ...int) int {
 
 // ToUint64E casts an interface to a uint64 type.
 func ToUint64E(i interface{}, defaultValue ...uint64) (value uint64, err error) {
+	return DefaultConverter().ToUint64E(i, defaultValue...)
+}
+
+// ToUint64E casts an interface to a uint64 type using policies of c.
+func (c Converter) ToUint64E(i interface{}, defaultValue ...uint64) (value uint64, err error) {
 	if v, ok := i.([]uint8); ok {
 		i = string(v)
 	}
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
 	i = indirect(i)
 	value = uint64(0)
 	err = nil
@@ -628,63 +921,25 @@ func ToUint64E(i interface{}, defaultValue ...uint64) (value uint64, err error)
 
 	switch s := i.(type) {
 	case string:
-		v, e := strconv.ParseUint(s, 0, 64)
+		v, e := c.parseUintString(s, 64)
 		if e == nil {
 			value = v
 		} else {
-			err = fmt.Errorf("unable to cast %#v to uint64: %s", i, err)
+			err = fmt.Errorf("unable to cast %#v to uint64: %w", i, e)
 		}
-	case int:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint64(s)
-		}
-	case int64:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint64(s)
-		}
-	case int32:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint64(s)
-		}
-	case int16:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint64(s)
-		}
-	case int8:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint64(s)
-		}
-	case uint:
-		value = uint64(s)
-	case uint64:
-		value = s
-	case uint32:
-		value = uint64(s)
-	case uint16:
-		value = uint64(s)
-	case uint8:
-		value = uint64(s)
-	case float32:
-		if s < 0 {
-			err = errNegativeNotAllowed
+	case int, int64, int32, int16, int8, uint, uint64, uint32, uint16, uint8:
+		v, e := intToUint64(s, 64)
+		if e == nil {
+			value = v
 		} else {
-			value = uint64(s)
+			err = e
 		}
 	case float64:
-		if s < 0 {
-			err = errNegativeNotAllowed
+		v, e := c.floatToUint64(s, 64)
+		if e == nil {
+			value = v
 		} else {
-			value = uint64(s)
+			err = e
 		}
 	case bool:
 		if s {
@@ -703,12 +958,18 @@ func ToUint64(i interface{}, defaultValue ...uint64) uint64 {
 
 // ToUint32E casts an interface to a uint32 type.
 func ToUint32E(i interface{}, defaultValue ...uint32) (value uint32, err error) {
+	return DefaultConverter().ToUint32E(i, defaultValue...)
+}
+
+// ToUint32E casts an interface to a uint32 type using policies of c.
+func (c Converter) ToUint32E(i interface{}, defaultValue ...uint32) (value uint32, err error) {
 	if v, ok := i.([]uint8); ok {
 		i = string(v)
 	}
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
 	i = indirect(i)
 	value = uint32(0)
 	err = nil
@@ -718,64 +979,32 @@ func ToUint32E(i interface{}, defaultValue ...uint32) (value uint32, err error)
 
 	switch s := i.(type) {
 	case string:
-		v, e := strconv.ParseUint(s, 0, 32)
+		v, e := c.parseUintString(s, 32)
 		if e == nil {
 			value = uint32(v)
 		} else {
-			err = fmt.Errorf("unable to cast %#v to uint32: %s", i, err)
+			err = fmt.Errorf("unable to cast %#v to uint32: %w", i, e)
 		}
-	case int:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint32(s)
-		}
-	case int64:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint32(s)
-		}
-	case int32:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint32(s)
-		}
-	case int16:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint32(s)
-		}
-	case int8:
-		if s < 0 {
-			err = errNegativeNotAllowed
+	case int, int64, int32, int16, int8, uint, uint64, uint32, uint16, uint8:
+		v, e := intToUint64(s, 32)
+		if e == nil {
+			value = uint32(v)
 		} else {
-			value = uint32(s)
+			err = e
 		}
-	case uint:
-		value = uint32(s)
-
-	case uint64:
-		value = uint32(s)
-	case uint32:
-		value = s
-	case uint16:
-		value = uint32(s)
-	case uint8:
-		value = uint32(s)
 	case float64:
-		if s < 0 {
-			err = errNegativeNotAllowed
+		v, e := c.floatToUint64(s, 32)
+		if e == nil {
+			value = uint32(v)
 		} else {
-			value = uint32(s)
+			err = e
 		}
 	case float32:
-		if s < 0 {
-			err = errNegativeNotAllowed
+		v, e := c.floatToUint64(float64(s), 32)
+		if e == nil {
+			value = uint32(v)
 		} else {
-			value = uint32(s)
+			err = e
 		}
 	case bool:
 		if s {
@@ -794,12 +1023,18 @@ func ToUint32(i interface{}, defaultValue ...uint32) uint32 {
 
 // ToUint16E casts an interface to a uint16 type.
 func ToUint16E(i interface{}, defaultValue ...uint16) (value uint16, err error) {
+	return DefaultConverter().ToUint16E(i, defaultValue...)
+}
+
+// ToUint16E casts an interface to a uint16 type using policies of c.
+func (c Converter) ToUint16E(i interface{}, defaultValue ...uint16) (value uint16, err error) {
 	if v, ok := i.([]uint8); ok {
 		i = string(v)
 	}
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
 	i = indirect(i)
 	value = uint16(0)
 	err = nil
@@ -809,63 +1044,32 @@ func ToUint16E(i interface{}, defaultValue ...uint16) (value uint16, err error)
 
 	switch s := i.(type) {
 	case string:
-		v, e := strconv.ParseUint(s, 0, 16)
+		v, e := c.parseUintString(s, 16)
 		if e == nil {
 			value = uint16(v)
 		} else {
-			err = fmt.Errorf("unable to cast %#v to uint16: %s", i, err)
-		}
-	case int:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint16(s)
-		}
-	case int64:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint16(s)
-		}
-	case int32:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint16(s)
-		}
-	case int16:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint16(s)
+			err = fmt.Errorf("unable to cast %#v to uint16: %w", i, e)
 		}
-	case int8:
-		if s < 0 {
-			err = errNegativeNotAllowed
+	case int, int64, int32, int16, int8, uint, uint64, uint32, uint16, uint8:
+		v, e := intToUint64(s, 16)
+		if e == nil {
+			value = uint16(v)
 		} else {
-			value = uint16(s)
+			err = e
 		}
-	case uint:
-		value = uint16(s)
-	case uint64:
-		value = uint16(s)
-	case uint32:
-		value = uint16(s)
-	case uint16:
-		value = s
-	case uint8:
-		value = uint16(s)
 	case float64:
-		if s < 0 {
-			err = errNegativeNotAllowed
+		v, e := c.floatToUint64(s, 16)
+		if e == nil {
+			value = uint16(v)
 		} else {
-			value = uint16(s)
+			err = e
 		}
 	case float32:
-		if s < 0 {
-			err = errNegativeNotAllowed
+		v, e := c.floatToUint64(float64(s), 16)
+		if e == nil {
+			value = uint16(v)
 		} else {
-			value = uint16(s)
+			err = e
 		}
 	case bool:
 		if s {
@@ -884,12 +1088,18 @@ func ToUint16(i interface{}, defaultValue ...uint16) uint16 {
 
 // ToUint8E casts an interface to a uint type.
 func ToUint8E(i interface{}, defaultValue ...uint8) (value uint8, err error) {
+	return DefaultConverter().ToUint8E(i, defaultValue...)
+}
+
+// ToUint8E casts an interface to a uint type using policies of c.
+func (c Converter) ToUint8E(i interface{}, defaultValue ...uint8) (value uint8, err error) {
 	if v, ok := i.([]uint8); ok {
 		i = string(v)
 	}
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
 	i = indirect(i)
 	value = uint8(0)
 	err = nil
@@ -899,63 +1109,32 @@ func ToUint8E(i interface{}, defaultValue ...uint8) (value uint8, err error) {
 
 	switch s := i.(type) {
 	case string:
-		v, e := strconv.ParseUint(s, 0, 8)
+		v, e := c.parseUintString(s, 8)
 		if e == nil {
 			value = uint8(v)
 		} else {
-			err = fmt.Errorf("unable to cast %#v to uint8: %s", i, err)
-		}
-	case int:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint8(s)
-		}
-	case int64:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint8(s)
-		}
-	case int32:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint8(s)
+			err = fmt.Errorf("unable to cast %#v to uint8: %w", i, e)
 		}
-	case int16:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint8(s)
-		}
-	case int8:
-		if s < 0 {
-			err = errNegativeNotAllowed
+	case int, int64, int32, int16, int8, uint, uint64, uint32, uint16, uint8:
+		v, e := intToUint64(s, 8)
+		if e == nil {
+			value = uint8(v)
 		} else {
-			value = uint8(s)
+			err = e
 		}
-	case uint:
-		value = uint8(s)
-	case uint64:
-		value = uint8(s)
-	case uint32:
-		value = uint8(s)
-	case uint16:
-		value = uint8(s)
-	case uint8:
-		value = s
 	case float64:
-		if s < 0 {
-			err = errNegativeNotAllowed
+		v, e := c.floatToUint64(s, 8)
+		if e == nil {
+			value = uint8(v)
 		} else {
-			value = uint8(s)
+			err = e
 		}
 	case float32:
-		if s < 0 {
-			err = errNegativeNotAllowed
+		v, e := c.floatToUint64(float64(s), 8)
+		if e == nil {
+			value = uint8(v)
 		} else {
-			value = uint8(s)
+			err = e
 		}
 	case bool:
 		if s {
@@ -974,12 +1153,18 @@ func ToUint8(i interface{}, defaultValue ...uint8) uint8 {
 
 // ToUintE casts an interface to a uint type.
 func ToUintE(i interface{}, defaultValue ...uint) (value uint, err error) {
+	return DefaultConverter().ToUintE(i, defaultValue...)
+}
+
+// ToUintE casts an interface to a uint type using policies of c.
+func (c Converter) ToUintE(i interface{}, defaultValue ...uint) (value uint, err error) {
 	if v, ok := i.([]uint8); ok {
 		i = string(v)
 	}
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
 	i = indirect(i)
 	value = uint(0)
 	err = nil
@@ -989,63 +1174,32 @@ func ToUintE(i interface{}, defaultValue ...uint) (value uint, err error) {
 
 	switch s := i.(type) {
 	case string:
-		v, e := strconv.ParseUint(s, 0, 0)
+		v, e := c.parseUintString(s, 0)
 		if e == nil {
 			value = uint(v)
 		} else {
-			err = fmt.Errorf("unable to cast %#v to uint: %s", i, err)
+			err = fmt.Errorf("unable to cast %#v to uint: %w", i, e)
 		}
-	case int:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint(s)
-		}
-	case int64:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint(s)
-		}
-	case int32:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint(s)
-		}
-	case int16:
-		if s < 0 {
-			err = errNegativeNotAllowed
-		} else {
-			value = uint(s)
-		}
-	case int8:
-		if s < 0 {
-			err = errNegativeNotAllowed
+	case int, int64, int32, int16, int8, uint, uint64, uint32, uint16, uint8:
+		v, e := intToUint64(s, 0)
+		if e == nil {
+			value = uint(v)
 		} else {
-			value = uint(s)
+			err = e
 		}
-	case uint:
-		value = s
-	case uint64:
-		value = uint(s)
-	case uint32:
-		value = uint(s)
-	case uint16:
-		value = uint(s)
-	case uint8:
-		value = uint(s)
 	case float64:
-		if s < 0 {
-			err = errNegativeNotAllowed
+		v, e := c.floatToUint64(s, 0)
+		if e == nil {
+			value = uint(v)
 		} else {
-			value = uint(s)
+			err = e
 		}
 	case float32:
-		if s < 0 {
-			err = errNegativeNotAllowed
+		v, e := c.floatToUint64(float64(s), 0)
+		if e == nil {
+			value = uint(v)
 		} else {
-			value = uint(s)
+			err = e
 		}
 	case bool:
 		if s {
@@ -1115,6 +1269,7 @@ func ToTimeE(i interface{}, timeFormat ...string) (value time.Time, err error) {
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
 	i = indirect(i)
 	value = time.Time{}
 	err = nil
@@ -1154,6 +1309,7 @@ func ToTimeStringE(i interface{}, timeFormat ...string) (value string, err error
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
 	i = indirect(i)
 	value = ""
 	err = nil
@@ -1235,14 +1391,77 @@ func ToTimeString(i interface{}, timeFormat ...string) string {
 	return v
 }
 
+// ToDurationE casts an interface to a time.Duration type.
+// Strings are parsed with time.ParseDuration ("300ms", "1h30m"), integer strings and numbers are nanoseconds.
+func ToDurationE(i interface{}, defaultValue ...time.Duration) (value time.Duration, err error) {
+	return DefaultConverter().ToDurationE(i, defaultValue...)
+}
+
+// ToDurationE casts an interface to a time.Duration type using policies of c.
+func (c Converter) ToDurationE(i interface{}, defaultValue ...time.Duration) (value time.Duration, err error) {
+	if v, ok := i.([]uint8); ok {
+		i = string(v)
+	}
+	if v, ok := i.(Value); ok {
+		i = v.value
+	}
+	i = underlying(i)
+	i = indirect(i)
+	value = time.Duration(0)
+	err = nil
+	if len(defaultValue) > 0 {
+		value = defaultValue[0]
+	}
+
+	switch s := i.(type) {
+	case time.Duration:
+		value = s
+	case string:
+		if n, e := c.parseIntString(s, 64); e == nil {
+			value = time.Duration(n)
+			return
+		}
+		v, e := time.ParseDuration(strings.TrimSpace(s))
+		if e == nil {
+			value = v
+		} else {
+			err = fmt.Errorf("unable to cast %#v of type %T to time.Duration: %w", i, i, e)
+		}
+	case nil:
+	default:
+		n, e := ToInt64E(s)
+		if e == nil {
+			value = time.Duration(n)
+		} else {
+			err = fmt.Errorf("unable to cast %#v of type %T to time.Duration", i, i)
+		}
+	}
+	return
+}
+func ToDuration(i interface{}, defaultValue ...time.Duration) time.Duration {
+	v, _ := ToDurationE(i, defaultValue...)
+	return v
+}
+
 // ToStringSliceE casts an interface to a []string type.
+// Strings are split by StringSplitMode.
 func ToStringSliceE(i interface{}, seperator ...string) (value []string, err error) {
+	return ToStringSliceWithE(i, splitOptions(seperator))
+}
+func ToStringSlice(i interface{}, seperator ...string) []string {
+	v, _ := ToStringSliceE(i, seperator...)
+	return v
+}
+
+// ToStringSliceWithE casts an interface to a []string type, strings are split by o.
+func ToStringSliceWithE(i interface{}, o SplitOptions) (value []string, err error) {
 	if v, ok := i.([]uint8); ok {
 		i = string(v)
 	}
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
 	value = []string{}
 	err = nil
 
@@ -1290,14 +1509,12 @@ func ToStringSliceE(i interface{}, seperator ...string) (value []string, err err
 			value = append(value, ToString(n))
 		}
 	case string:
-		if len(seperator) > 0 {
-			value = strings.Split(v, seperator[0])
-		} else {
-			f := func(c rune) bool {
-				return !unicode.IsLetter(c) && !unicode.IsNumber(c)
-			}
-			value = strings.FieldsFunc(v, f)
+		s, e := splitString(v, o)
+		if e != nil {
+			err = fmt.Errorf("unable to cast %#v of type %T to []string: %w", i, i, e)
+			return
 		}
+		value = s
 
 	case interface{}:
 		str, e := ToStringE(v)
@@ -1305,24 +1522,28 @@ func ToStringSliceE(i interface{}, seperator ...string) (value []string, err err
 			err = fmt.Errorf("unable to cast %#v of type %T to []string", i, i)
 			return
 		}
-		value, err = ToStringSliceE(str)
+		value, err = ToStringSliceWithE(str, o)
 	case float64, float32:
-		value, err = ToStringSliceE(ToString(v))
+		value, err = ToStringSliceWithE(ToString(v), o)
 	default:
 		err = fmt.Errorf("unable to cast %#v of type %T to []string", i, i)
 	}
 	return
 }
-func ToStringSlice(i interface{}, seperator ...string) []string {
-	v, _ := ToStringSliceE(i, seperator...)
+func ToStringSliceWith(i interface{}, o SplitOptions) []string {
+	v, _ := ToStringSliceWithE(i, o)
 	return v
 }
 
 // ToMapE casts an interface to a map[string]interface{} type.
-func ToMapE(i interface{}) (value map[string]interface{}, err error) {
+// Any map kind is accepted with keys converted by ToStringE, structs are converted by field tags.
+// Strings are decoded as JSON objects, or as key, value pairs when MapOptions.KeyValue is set.
+func ToMapE(i interface{}, options ...MapOptions) (value map[string]interface{}, err error) {
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
+	o := mapOptions(options)
 	value = map[string]interface{}{}
 	err = nil
 
@@ -1332,7 +1553,15 @@ func ToMapE(i interface{}) (value map[string]interface{}, err error) {
 			value[ToString(k)] = val
 		}
 	case map[string]interface{}:
-		value = v
+		if o.Copy {
+			for k, val := range v {
+				value[k] = val
+			}
+		} else {
+			value = v
+		}
+	case *OrderedMap:
+		value = v.Map()
 	case map[string]Value:
 		for k, val := range v {
 			value[k] = val.Interface()
@@ -1346,16 +1575,18 @@ func ToMapE(i interface{}) (value map[string]interface{}, err error) {
 			value[k.String()] = val
 		}
 	case string:
-		err = json.Unmarshal([]uint8(v), &value)
+		value, err = stringToMap(v, o)
 	case []uint8:
-		err = json.Unmarshal(v, &value)
+		value, err = stringToMap(string(v), o)
 	default:
-		err = fmt.Errorf("unable to cast %#v of type %T to map[string]interface{}", i, i)
+		if e := reflectMap(i, o, value); e != nil {
+			err = fmt.Errorf("unable to cast %#v of type %T to map[string]interface{}: %w", i, i, e)
+		}
 	}
 	return
 }
-func ToMap(i interface{}) map[string]interface{} {
-	v, _ := ToMapE(i)
+func ToMap(i interface{}, options ...MapOptions) map[string]interface{} {
+	v, _ := ToMapE(i, options...)
 	return v
 }
 
@@ -1363,6 +1594,7 @@ func ToMapSliceE(i interface{}) (value []map[string]interface{}, err error) {
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
 	value = []map[string]interface{}{}
 	err = nil
 
@@ -1402,8 +1634,8 @@ func ToMapGetE(i interface{}, path string) (Value, error) {
 		}
 
 		if i+1 < len(keys) {
-			parent, ok = v.(map[string]interface{})
-			if !ok {
+			parent, err = ToMapE(v)
+			if err != nil {
 				return New(nil), errors.New("Part '" + key + "' in path '" + path + "' is not 'map[string]interface{}' type")
 			}
 		}
@@ -1418,13 +1650,24 @@ func ToMapGet(i interface{}, path string) Value {
 }
 
 // ToSliceE casts an interface to a []interface{} type.
+// Maps are converted to key, value pairs ordered by SortKeys, OrderedMap keeps insertion order.
 func ToSliceE(i interface{}, seperator ...string) (value []interface{}, err error) {
+	return ToSliceWithE(i, splitOptions(seperator))
+}
+func ToSlice(i interface{}, seperator ...string) []interface{} {
+	v, _ := ToSliceE(i, seperator...)
+	return v
+}
+
+// ToSliceWithE casts an interface to a []interface{} type, strings are split by o.
+func ToSliceWithE(i interface{}, o SplitOptions) (value []interface{}, err error) {
 	if v, ok := i.([]uint8); ok {
 		i = string(v)
 	}
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
 	value = []interface{}{}
 	err = nil
 
@@ -1432,12 +1675,17 @@ func ToSliceE(i interface{}, seperator ...string) (value []interface{}, err erro
 	case []interface{}:
 		value = v
 	case map[string]interface{}:
-		for k, val := range v {
+		for _, k := range sortedKeys(v) {
+			value = append(value, k)
+			value = append(value, v[k])
+		}
+	case *OrderedMap:
+		for _, k := range v.keys {
 			value = append(value, k)
-			value = append(value, val)
+			value = append(value, v.values[k])
 		}
 	case string, float64, float32, int64, int32, int16, int8, int, uint64, uint32, uint16, uint8, uint:
-		strArr, e := ToStringSliceE(v, seperator...)
+		strArr, e := ToStringSliceWithE(v, o)
 		if e != nil {
 			err = fmt.Errorf("unable to cast %#v of type %T to []interface{}", i, i)
 			return
@@ -1451,78 +1699,32 @@ func ToSliceE(i interface{}, seperator ...string) (value []interface{}, err erro
 	}
 	return
 }
-func ToSlice(i interface{}, seperator ...string) []interface{} {
-	v, _ := ToSliceE(i, seperator...)
+func ToSliceWith(i interface{}, o SplitOptions) []interface{} {
+	v, _ := ToSliceWithE(i, o)
 	return v
 }
 
 // ToIntSliceE casts an interface to a []int type.
 func ToIntSliceE(i interface{}, seperator ...string) (value []int, err error) {
-	if v, ok := i.([]uint8); ok {
-		i = string(v)
-	}
-	if v, ok := i.(Value); ok {
-		i = v.value
-	}
-	value = []int{}
-	err = nil
-	if i == nil {
-		err = fmt.Errorf("unable to cast %#v of type %T to []int", i, i)
-		return
-	}
-
-	switch v := i.(type) {
-	case []int:
-		value = v
-		return
-	case string, float64, float32, int64, int32, int16, int8, int, uint64, uint32, uint16, uint8, uint:
-		strArr, e := ToStringSliceE(v, seperator...)
-		if e != nil {
-			err = fmt.Errorf("unable to cast %#v of type %T to []int", i, i)
-			return
-		}
-		a := make([]int, len(strArr))
-		for i, inter := range strArr {
-			val, e := ToIntE(inter)
-			if e != nil {
-				err = fmt.Errorf("unable to cast %#v of type %T to []int", i, i)
-				return
-			}
-			a[i] = val
-		}
-		value = a
-	}
-
-	kind := reflect.TypeOf(i).Kind()
-	switch kind {
-	case reflect.Slice, reflect.Array:
-		s := reflect.ValueOf(i)
-		a := make([]int, s.Len())
-		for j := 0; j < s.Len(); j++ {
-			val, e := ToIntE(s.Index(j).Interface())
-			if e != nil {
-				err = fmt.Errorf("unable to cast %#v of type %T to []int", i, i)
-				return
-			}
-			a[j] = val
-		}
-		value = a
-	default:
-		err = fmt.Errorf("unable to cast %#v of type %T to []int", i, i)
-	}
-	return
+	return ToIntSliceWithE(i, splitOptions(seperator))
 }
 func ToIntSlice(i interface{}, seperator ...string) []int {
 	v, _ := ToIntSliceE(i, seperator...)
 	return v
 }
 
+// ToIntSliceWithE casts an interface to a []int type, strings are split by o.
+func ToIntSliceWithE(i interface{}, o SplitOptions) (value []int, err error) {
+	return toSliceE(i, func(i interface{}) (int, error) { return ToIntE(i) }, o)
+}
+func ToIntSliceWith(i interface{}, o SplitOptions) []int {
+	v, _ := ToIntSliceWithE(i, o)
+	return v
+}
+
 // ToBoolSliceE casts an interface to a []bool type.
 // boolTrueAndSeperator 1: boolTrue, 2:seperator, empty string "" to skip parameter
 func ToBoolSliceE(i interface{}, boolTrueAndSeperator ...string) (value []bool, err error) {
-	if v, ok := i.([]uint8); ok {
-		i = string(v)
-	}
 	boolTrue := []string{}
 	seperator := []string{}
 	if len(boolTrueAndSeperator) > 0 && boolTrueAndSeperator[0] != "" {
@@ -1531,67 +1733,25 @@ func ToBoolSliceE(i interface{}, boolTrueAndSeperator ...string) (value []bool,
 	if len(boolTrueAndSeperator) > 1 && boolTrueAndSeperator[1] != "" {
 		seperator = append(seperator, boolTrueAndSeperator[1])
 	}
-	if v, ok := i.(Value); ok {
-		i = v.value
-	}
-	value = []bool{}
-	err = nil
-	if i == nil {
-		err = fmt.Errorf("unable to cast %#v of type %T to []bool", i, i)
-	}
-
-	switch v := i.(type) {
-	case []bool:
-		value = v
-		return
-	case string, float64, float32, int64, int32, int16, int8, int, uint64, uint32, uint16, uint8, uint:
-		strArr, e := ToStringSliceE(v, seperator...)
-		if e != nil {
-			err = fmt.Errorf("unable to cast %#v of type %T to []bool", i, i)
-			return
-		}
-		a := make([]bool, len(strArr))
-		for i, inter := range strArr {
-			val, e := ToBoolE(inter, boolTrue...)
-			if e != nil {
-				err = fmt.Errorf("unable to cast %#v of type %T to []bool", i, i)
-				return
-			}
-			a[i] = val
-		}
-		value = a
-	}
-
-	kind := reflect.TypeOf(i).Kind()
-	switch kind {
-	case reflect.Slice, reflect.Array:
-		s := reflect.ValueOf(i)
-		a := make([]bool, s.Len())
-		for j := 0; j < s.Len(); j++ {
-			val, e := ToBoolE(s.Index(j).Interface(), boolTrue...)
-			if e != nil {
-				err = fmt.Errorf("unable to cast %#v of type %T to []bool", i, i)
-				return
-			}
-			a[j] = val
-		}
-		value = a
-	default:
-		err = fmt.Errorf("unable to cast %#v of type %T to []bool", i, i)
-	}
-	return
+	return ToBoolSliceWithE(i, splitOptions(seperator), boolTrue...)
 }
 func ToBoolSlice(i interface{}, boolTrueAndSeperator ...string) []bool {
 	v, _ := ToBoolSliceE(i, boolTrueAndSeperator...)
 	return v
 }
 
+// ToBoolSliceWithE casts an interface to a []bool type, strings are split by o.
+func ToBoolSliceWithE(i interface{}, o SplitOptions, boolTrue ...string) (value []bool, err error) {
+	return toSliceE(i, func(i interface{}) (bool, error) { return ToBoolE(i, boolTrue...) }, o)
+}
+func ToBoolSliceWith(i interface{}, o SplitOptions, boolTrue ...string) []bool {
+	v, _ := ToBoolSliceWithE(i, o, boolTrue...)
+	return v
+}
+
 // ToTimeSliceE casts an interface to a []time.Time type.
 // timeFormatAndSeperator 1: timeFormat, 2:seperator, empty string "" to skip parameter
 func ToTimeSliceE(i interface{}, timeFormatAndSeperator ...string) (value []time.Time, err error) {
-	if v, ok := i.([]uint8); ok {
-		i = string(v)
-	}
 	timeFormat := []string{}
 	seperator := []string{}
 	if len(timeFormatAndSeperator) > 0 && timeFormatAndSeperator[0] != "" {
@@ -1600,68 +1760,35 @@ func ToTimeSliceE(i interface{}, timeFormatAndSeperator ...string) (value []time
 	if len(timeFormatAndSeperator) > 1 && timeFormatAndSeperator[1] != "" {
 		seperator = append(seperator, timeFormatAndSeperator[1])
 	}
-	if v, ok := i.(Value); ok {
-		i = v.value
-	}
-	value = []time.Time{}
-	err = nil
-	if i == nil {
-		err = fmt.Errorf("unable to cast %#v of type %T to []time.Time", i, i)
-	}
-
-	switch v := i.(type) {
-	case []time.Time:
-		value = v
-		return
-	case string, float64, float32, int64, int32, int16, int8, int, uint64, uint32, uint16, uint8, uint:
-		strArr, e := ToStringSliceE(v, seperator...)
-		if e != nil {
-			err = fmt.Errorf("unable to cast %#v of type %T to []time.Time", i, i)
-			return
-		}
-		a := make([]time.Time, len(strArr))
-		for i, inter := range strArr {
-			val, e := ToTimeE(inter, timeFormat...)
-			if e != nil {
-				err = fmt.Errorf("unable to cast %#v of type %T to []time.Time", i, i)
-				return
-			}
-			a[i] = val
-		}
-		value = a
-	}
-
-	kind := reflect.TypeOf(i).Kind()
-	switch kind {
-	case reflect.Slice, reflect.Array:
-		s := reflect.ValueOf(i)
-		a := make([]time.Time, s.Len())
-		for j := 0; j < s.Len(); j++ {
-			val, err := ToTimeE(s.Index(j).Interface(), timeFormat...)
-			if err != nil {
-				return []time.Time{}, fmt.Errorf("unable to cast %#v of type %T to []time.Time", i, i)
-			}
-			a[j] = val
-		}
-		value = a
-	default:
-		err = fmt.Errorf("unable to cast %#v of type %T to []time.Time", i, i)
-	}
-	return
+	return ToTimeSliceWithE(i, splitOptions(seperator), timeFormat...)
 }
 func ToTimeSlice(i interface{}, timeFormatAndSeperator ...string) []time.Time {
 	v, _ := ToTimeSliceE(i, timeFormatAndSeperator...)
 	return v
 }
 
-// ToValueMapE casts an interface to a map[string]interface{} type.
-func ToValueMapE(i interface{}) (value map[string]Value, err error) {
+// ToTimeSliceWithE casts an interface to a []time.Time type, strings are split by o.
+// Elements are parsed with timeFormat[0], or with known formats when it is not provided.
+func ToTimeSliceWithE(i interface{}, o SplitOptions, timeFormat ...string) (value []time.Time, err error) {
+	return toSliceE(i, func(i interface{}) (time.Time, error) { return ToTimeE(i, timeFormat...) }, o)
+}
+func ToTimeSliceWith(i interface{}, o SplitOptions, timeFormat ...string) []time.Time {
+	v, _ := ToTimeSliceWithE(i, o, timeFormat...)
+	return v
+}
+
+// ToValueMapE casts an interface to a map[string]Value type.
+// Any map kind is accepted with keys converted by ToStringE, structs are converted by field tags.
+// Strings are decoded as JSON objects, or as key, value pairs when MapOptions.KeyValue is set.
+func ToValueMapE(i interface{}, options ...MapOptions) (value map[string]Value, err error) {
 	if v, ok := i.([]uint8); ok {
 		i = string(v)
 	}
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
+	o := mapOptions(options)
 	value = map[string]Value{}
 	err = nil
 
@@ -1674,8 +1801,18 @@ func ToValueMapE(i interface{}) (value map[string]Value, err error) {
 		for k, val := range v {
 			value[k] = New(val)
 		}
+	case *OrderedMap:
+		for k, val := range v.values {
+			value[k] = New(val)
+		}
 	case map[string]Value:
-		value = v
+		if o.Copy {
+			for k, val := range v {
+				value[k] = val
+			}
+		} else {
+			value = v
+		}
 	case map[Value]Value:
 		for k, val := range v {
 			value[k.String()] = val
@@ -1684,24 +1821,48 @@ func ToValueMapE(i interface{}) (value map[string]Value, err error) {
 		for k, val := range v {
 			value[k.String()] = New(val)
 		}
+	case string:
+		m, e := stringToMap(v, o)
+		err = e
+		for k, val := range m {
+			value[k] = New(val)
+		}
 	default:
-		err = fmt.Errorf("unable to cast %#v of type %T to map[string]Value", i, i)
+		m := map[string]interface{}{}
+		if e := reflectMap(i, o, m); e != nil {
+			err = fmt.Errorf("unable to cast %#v of type %T to map[string]Value: %w", i, i, e)
+			return
+		}
+		for k, val := range m {
+			value[k] = New(val)
+		}
 	}
 	return
 }
-func ToValueMap(i interface{}) map[string]Value {
-	v, _ := ToValueMapE(i)
+func ToValueMap(i interface{}, options ...MapOptions) map[string]Value {
+	v, _ := ToValueMapE(i, options...)
 	return v
 }
 
-// ToSliceE casts an interface to a []interface{} type.
+// ToValueSliceE casts an interface to a []Value type.
+// Maps are converted to key, value pairs ordered by SortKeys, OrderedMap keeps insertion order.
 func ToValueSliceE(i interface{}, seperator ...string) (value []Value, err error) {
+	return ToValueSliceWithE(i, splitOptions(seperator))
+}
+func ToValueSlice(i interface{}, seperator ...string) []Value {
+	v, _ := ToValueSliceE(i, seperator...)
+	return v
+}
+
+// ToValueSliceWithE casts an interface to a []Value type, strings are split by o.
+func ToValueSliceWithE(i interface{}, o SplitOptions) (value []Value, err error) {
 	if v, ok := i.([]uint8); ok {
 		i = string(v)
 	}
 	if v, ok := i.(Value); ok {
 		i = v.value
 	}
+	i = underlying(i)
 	value = []Value{}
 	err = nil
 
@@ -1713,12 +1874,17 @@ func ToValueSliceE(i interface{}, seperator ...string) (value []Value, err error
 			value = append(value, New(val))
 		}
 	case map[string]interface{}:
-		for k, val := range v {
+		for _, k := range sortedKeys(v) {
 			value = append(value, New(k))
-			value = append(value, New(val))
+			value = append(value, New(v[k]))
+		}
+	case *OrderedMap:
+		for _, k := range v.keys {
+			value = append(value, New(k))
+			value = append(value, New(v.values[k]))
 		}
 	case string, float64, float32, int64, int32, int16, int8, int, uint64, uint32, uint16, uint8, uint:
-		strArr, e := ToStringSliceE(v, seperator...)
+		strArr, e := ToStringSliceWithE(v, o)
 		if e != nil {
 			err = fmt.Errorf("unable to cast %#v of type %T to []Value", i, i)
 			return
@@ -1732,7 +1898,328 @@ func ToValueSliceE(i interface{}, seperator ...string) (value []Value, err error
 	}
 	return
 }
-func ToValueSlice(i interface{}, seperator ...string) []Value {
-	v, _ := ToValueSliceE(i, seperator...)
+func ToValueSliceWith(i interface{}, o SplitOptions) []Value {
+	v, _ := ToValueSliceWithE(i, o)
 	return v
 }
+
+var durationType = reflect.TypeOf(time.Duration(0))
+var int64Type = reflect.TypeOf(int64(0))
+
+// ConvertTo converts i into value pointed by target.
+// Targets implementing encoding.TextUnmarshaler are populated from ToStringE of i,
+// targets implementing json.Unmarshaler from JSON of i (strings which are not valid JSON are encoded as JSON strings).
+// Other targets are populated by conversion matching their kind, so named types like enums are supported.
+// Structs are populated field by field from maps, structs or JSON objects by json tags or field names,
+// slices, arrays and maps element by element, so string "2" from query or form becomes int field 2.
+func ConvertTo(i interface{}, target interface{}) error {
+	if v, ok := i.(Value); ok {
+		i = v.value
+	}
+	t := reflect.ValueOf(target)
+	if t.Kind() != reflect.Ptr || t.IsNil() {
+		return fmt.Errorf("target %T must be non nil pointer", target)
+	}
+
+	e := t.Elem()
+	if i != nil && reflect.TypeOf(i) == e.Type() {
+		e.Set(reflect.ValueOf(i))
+		return nil
+	}
+	// time.Time implements encoding.TextUnmarshaler, but ToTimeE understands more formats
+	switch e.Interface().(type) {
+	case time.Time:
+		v, err := ToTimeE(i)
+		if err == nil {
+			e.Set(reflect.ValueOf(v))
+		}
+		return err
+	case Value:
+		e.Set(reflect.ValueOf(New(i)))
+		return nil
+	}
+
+	switch u := target.(type) {
+	case encoding.TextUnmarshaler:
+		s, err := ToStringE(i)
+		if err != nil {
+			return err
+		}
+		return u.UnmarshalText([]uint8(s))
+	case json.Unmarshaler:
+		data, err := toJSON(i)
+		if err != nil {
+			return err
+		}
+		return u.UnmarshalJSON(data)
+	}
+
+	// time.Duration and types defined on it are int64 kind, but strings like "300ms" are parsed by ToDurationE
+	if e.Kind() == reflect.Int64 && e.Type() != int64Type && e.Type().ConvertibleTo(durationType) {
+		v, err := ToDurationE(i)
+		if err == nil {
+			e.Set(reflect.ValueOf(v).Convert(e.Type()))
+		}
+		return err
+	}
+
+	switch e.Kind() {
+	case reflect.Ptr:
+		if isNil(i) {
+			e.Set(reflect.Zero(e.Type()))
+			return nil
+		}
+		p := reflect.New(e.Type().Elem())
+		if err := ConvertTo(i, p.Interface()); err != nil {
+			return err
+		}
+		e.Set(p)
+	case reflect.Interface:
+		if i == nil {
+			e.Set(reflect.Zero(e.Type()))
+		} else if reflect.TypeOf(i).Implements(e.Type()) {
+			e.Set(reflect.ValueOf(i))
+		} else {
+			return fmt.Errorf("unable to cast %#v of type %T to %s", i, i, e.Type())
+		}
+	case reflect.String:
+		v, err := ToStringE(i)
+		if err != nil {
+			return err
+		}
+		e.SetString(v)
+	case reflect.Bool:
+		v, err := ToBoolE(i)
+		if err != nil {
+			return err
+		}
+		e.SetBool(v)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := ToInt64E(i)
+		if err != nil {
+			return err
+		}
+		if e.OverflowInt(v) {
+			return fmt.Errorf("unable to cast %#v of type %T to %s: %w", i, i, e.Type(), strconv.ErrRange)
+		}
+		e.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v, err := ToUint64E(i)
+		if err != nil {
+			return err
+		}
+		if e.OverflowUint(v) {
+			return fmt.Errorf("unable to cast %#v of type %T to %s: %w", i, i, e.Type(), strconv.ErrRange)
+		}
+		e.SetUint(v)
+	case reflect.Float32, reflect.Float64:
+		v, err := ToFloat64E(i)
+		if err != nil {
+			return err
+		}
+		e.SetFloat(v)
+	case reflect.Complex64, reflect.Complex128:
+		v, err := ToComplex128E(i)
+		if err != nil {
+			return err
+		}
+		e.SetComplex(v)
+	case reflect.Struct:
+		return convertToStruct(i, e)
+	case reflect.Slice, reflect.Array:
+		return convertToSlice(i, e)
+	case reflect.Map:
+		return convertToMap(i, e)
+	default:
+		return fmt.Errorf("unable to cast %#v of type %T to %s", i, i, e.Type())
+	}
+	return nil
+}
+
+// convertToStruct sets fields of struct e from map, struct or JSON object i.
+// Keys are matched to json tag or field name, case-insensitively when there is no exact match,
+// values are converted by ConvertTo. Fields without key keep their values.
+func convertToStruct(i interface{}, e reflect.Value) error {
+	if isNil(i) {
+		return nil
+	}
+	m, err := ToMapE(i)
+	if err != nil {
+		return fmt.Errorf("unable to cast %#v of type %T to %s: %w", i, i, e.Type(), err)
+	}
+	_, err = setStructFields(m, e)
+	return err
+}
+
+// setStructFields sets fields of struct e from m, embedded structs are flattened like in encoding/json
+func setStructFields(m map[string]interface{}, e reflect.Value) (set int, err error) {
+	t := e.Type()
+	for j := 0; j < t.NumField(); j++ {
+		f := t.Field(j)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		fv := e.Field(j)
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				if fv.Kind() != reflect.Ptr {
+					n, err := setStructFields(m, fv)
+					set += n
+					if err != nil {
+						return set, err
+					}
+					continue
+				}
+				if !f.IsExported() {
+					continue
+				}
+				p := fv
+				if p.IsNil() {
+					p = reflect.New(ft)
+				}
+				n, err := setStructFields(m, p.Elem())
+				if n > 0 {
+					fv.Set(p)
+				}
+				set += n
+				if err != nil {
+					return set, err
+				}
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		v, ok := m[name]
+		if !ok {
+			for k, val := range m {
+				if strings.EqualFold(k, name) {
+					v, ok = val, true
+					break
+				}
+			}
+		}
+		if !ok {
+			continue
+		}
+		if err := ConvertTo(v, fv.Addr().Interface()); err != nil {
+			return set, fmt.Errorf("unable to cast field %s of %s: %w", f.Name, t, err)
+		}
+		set++
+	}
+	return
+}
+
+// convertToSlice sets slice or array e from slice, array or string i converting elements by ConvertTo.
+// Strings are decoded as JSON arrays or split like ToValueSliceE, []byte targets take strings as is.
+func convertToSlice(i interface{}, e reflect.Value) error {
+	if v, ok := i.(Value); ok {
+		i = v.value
+	}
+	if e.Type().Elem().Kind() == reflect.Uint8 && e.Kind() == reflect.Slice {
+		if s, ok := indirect(underlying(i)).(string); ok {
+			e.SetBytes([]uint8(s))
+			return nil
+		}
+	}
+	if isNil(i) {
+		e.Set(reflect.Zero(e.Type()))
+		return nil
+	}
+	elements, err := sliceElements(i)
+	if err != nil {
+		return fmt.Errorf("unable to cast %#v of type %T to %s: %w", i, i, e.Type(), err)
+	}
+
+	s := e
+	if e.Kind() == reflect.Slice {
+		s = reflect.MakeSlice(e.Type(), len(elements), len(elements))
+	}
+	for j := 0; j < s.Len(); j++ {
+		if j >= len(elements) {
+			s.Index(j).Set(reflect.Zero(s.Type().Elem()))
+			continue
+		}
+		if err := ConvertTo(elements[j], s.Index(j).Addr().Interface()); err != nil {
+			return fmt.Errorf("unable to cast element %d of %#v of type %T to %s: %w", j, i, i, e.Type(), err)
+		}
+	}
+	e.Set(s)
+	return nil
+}
+
+// sliceElements returns elements of slice or array i, strings are decoded as JSON arrays or split like ToValueSliceE
+func sliceElements(i interface{}) ([]interface{}, error) {
+	if v, ok := i.([]uint8); ok {
+		i = string(v)
+	}
+	i = indirect(underlying(i))
+	if s, ok := i.(string); ok && strings.HasPrefix(strings.TrimSpace(s), "[") {
+		v, err := decodeOrderedJSON([]uint8(s))
+		if err != nil {
+			return nil, err
+		}
+		i = v
+	}
+	if v := reflect.ValueOf(i); v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		elements := make([]interface{}, v.Len())
+		for j := range elements {
+			elements[j] = v.Index(j).Interface()
+		}
+		return elements, nil
+	}
+	values, err := ToValueSliceE(i)
+	if err != nil {
+		return nil, err
+	}
+	elements := make([]interface{}, len(values))
+	for j, v := range values {
+		elements[j] = v
+	}
+	return elements, nil
+}
+
+// convertToMap sets entries of map e from map, struct or JSON object i converting keys and values by ConvertTo
+func convertToMap(i interface{}, e reflect.Value) error {
+	if isNil(i) {
+		e.Set(reflect.Zero(e.Type()))
+		return nil
+	}
+	m, err := ToMapE(i)
+	if err != nil {
+		return fmt.Errorf("unable to cast %#v of type %T to %s: %w", i, i, e.Type(), err)
+	}
+	if e.IsNil() {
+		e.Set(reflect.MakeMapWithSize(e.Type(), len(m)))
+	}
+	for k, v := range m {
+		key := reflect.New(e.Type().Key())
+		if err := ConvertTo(k, key.Interface()); err != nil {
+			return fmt.Errorf("unable to cast key %q to %s: %w", k, e.Type().Key(), err)
+		}
+		val := reflect.New(e.Type().Elem())
+		if err := ConvertTo(v, val.Interface()); err != nil {
+			return fmt.Errorf("unable to cast value of key %q to %s: %w", k, e.Type().Elem(), err)
+		}
+		e.SetMapIndex(key.Elem(), val.Elem())
+	}
+	return nil
+}
+
+// toJSON returns JSON of i, strings holding valid JSON are returned as is
+func toJSON(i interface{}) ([]uint8, error) {
+	i = underlying(i)
+	if s, ok := i.(string); ok && json.Valid([]uint8(s)) {
+		return []uint8(s), nil
+	}
+	return json.Marshal(i)
+}
@@ -0,0 +1,65 @@
+package value
+
+import (
+	"testing"
+	"time"
+)
+
+type testTimeout time.Duration
+
+func TestConvertToDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		convert func(interface{}) (interface{}, error)
+		want    interface{}
+		err     bool
+	}{
+		{"duration string", "300ms", func(i interface{}) (interface{}, error) {
+			var d time.Duration
+			return d, ConvertTo(i, &d)
+		}, 300 * time.Millisecond, false},
+		{"duration number", 5, func(i interface{}) (interface{}, error) {
+			var d time.Duration
+			return d, ConvertTo(i, &d)
+		}, time.Duration(5), false},
+		{"named duration string", "1.5s", func(i interface{}) (interface{}, error) {
+			var d testTimeout
+			return d, ConvertTo(i, &d)
+		}, testTimeout(1500 * time.Millisecond), false},
+		{"named duration value", New("2m"), func(i interface{}) (interface{}, error) {
+			var d testTimeout
+			return d, ConvertTo(i, &d)
+		}, testTimeout(2 * time.Minute), false},
+		{"named duration field", map[string]interface{}{"timeout": "300ms"}, func(i interface{}) (interface{}, error) {
+			var s struct {
+				Timeout testTimeout `json:"timeout"`
+			}
+			return s.Timeout, ConvertTo(i, &s)
+		}, testTimeout(300 * time.Millisecond), false},
+		{"named duration invalid", "soon", func(i interface{}) (interface{}, error) {
+			var d testTimeout
+			return d, ConvertTo(i, &d)
+		}, testTimeout(0), true},
+		{"int64 keeps integers", "5", func(i interface{}) (interface{}, error) {
+			var n int64
+			return n, ConvertTo(i, &n)
+		}, int64(5), false},
+		{"int64 rejects units", "300ms", func(i interface{}) (interface{}, error) {
+			var n int64
+			return n, ConvertTo(i, &n)
+		}, int64(0), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.convert(test.input)
+			if (err != nil) != test.err {
+				t.Fatalf("ConvertTo(%v) error = %v, want error %v", test.input, err, test.err)
+			}
+			if got != test.want {
+				t.Errorf("ConvertTo(%v) = %v, want %v", test.input, got, test.want)
+			}
+		})
+	}
+}
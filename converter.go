@@ -0,0 +1,64 @@
+package value
+
+// Converter holds conversion policies for a single call site, goroutine or library,
+// so that code with different needs does not have to change package variables.
+// Package functions like ToIntE and ToBoolE use DefaultConverter.
+//
+//	c := value.DefaultConverter()
+//	c.IntRounding = value.RoundReject
+//	n, err := c.ToIntE("12.5") // ErrFractionalNotAllowed
+//
+// Zero IntLiteralBases, BoolVocabulary without words and nil SortKeys fall back to package variables.
+// Split mode of slice conversions is set per call by SplitOptions.Mode.
+type Converter struct {
+	// IntRounding is applied by integer conversions to floats and float strings
+	IntRounding RoundingMode
+	// Policies applied to special float values
+	NaNPolicy          SpecialFloatPolicy
+	InfPolicy          SpecialFloatPolicy
+	NegativeZeroPolicy SpecialFloatPolicy
+	// SpecialFloatStrings allows parsing "NaN" and "Inf" strings
+	SpecialFloatStrings bool
+	// IntLiteralBases are the bases accepted by integer conversions of strings
+	IntLiteralBases IntBase
+	// BoolVocabulary is the set of words recognized by ToBoolE
+	BoolVocabulary BoolWords
+	// SortKeys orders map keys in ToEntriesE
+	SortKeys func([]string)
+}
+
+// DefaultConverter returns Converter with current values of package variables
+// IntRounding, NaNPolicy, InfPolicy, NegativeZeroPolicy, SpecialFloatStrings, IntLiteralBases, BoolVocabulary and SortKeys
+func DefaultConverter() Converter {
+	return Converter{
+		IntRounding:         IntRounding,
+		NaNPolicy:           NaNPolicy,
+		InfPolicy:           InfPolicy,
+		NegativeZeroPolicy:  NegativeZeroPolicy,
+		SpecialFloatStrings: SpecialFloatStrings,
+		IntLiteralBases:     IntLiteralBases,
+		BoolVocabulary:      BoolVocabulary,
+		SortKeys:            SortKeys,
+	}
+}
+
+func (c Converter) intLiteralBases() IntBase {
+	if c.IntLiteralBases == 0 {
+		return IntLiteralBases
+	}
+	return c.IntLiteralBases
+}
+
+func (c Converter) boolVocabulary() BoolWords {
+	if len(c.BoolVocabulary.True) == 0 && len(c.BoolVocabulary.False) == 0 {
+		return BoolVocabulary
+	}
+	return c.BoolVocabulary
+}
+
+func (c Converter) sortKeys() func([]string) {
+	if c.SortKeys == nil {
+		return SortKeys
+	}
+	return c.SortKeys
+}
@@ -0,0 +1,93 @@
+package value
+
+import (
+	"os"
+	"sort"
+	"strings"
+)
+
+// EnvCase selects case folding of keys by FromEnv
+type EnvCase int
+
+const (
+	// EnvLower lower cases keys, APP_DB__HOST becomes db.host
+	EnvLower EnvCase = iota
+	// EnvUpper upper cases keys
+	EnvUpper
+	// EnvKeep keeps case of keys
+	EnvKeep
+)
+
+// EnvOptions configure FromEnv
+type EnvOptions struct {
+	// Delimiter nests keys, "__" when empty
+	Delimiter string
+	// Case folding of keys
+	Case EnvCase
+	// Environ is used instead of os.Environ, entries are "key=value"
+	Environ []string
+}
+
+// FromEnv returns environment variables starting with prefix as nested map of string values.
+// Prefix and the following "_" are removed, keys are nested by Delimiter:
+// APP_DB__HOST=x with prefix "APP" becomes {"db": {"host": "x"}}.
+// Empty prefix returns all variables. When variable is both value and nested key, nested keys win.
+func FromEnv(prefix string, options ...EnvOptions) map[string]interface{} {
+	o := EnvOptions{}
+	if len(options) > 0 {
+		o = options[0]
+	}
+	if o.Delimiter == "" {
+		o.Delimiter = "__"
+	}
+	environ := o.Environ
+	if environ == nil {
+		environ = os.Environ()
+	}
+	if prefix != "" && !strings.HasSuffix(prefix, "_") {
+		prefix += "_"
+	}
+
+	vars := map[string]string{}
+	for _, kv := range environ {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok || !strings.HasPrefix(k, prefix) || len(k) == len(prefix) {
+			continue
+		}
+		vars[k[len(prefix):]] = v
+	}
+	keys := make([]string, 0, len(vars))
+	for k := range vars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	value := map[string]interface{}{}
+	for _, k := range keys {
+		path := strings.Split(envCase(k, o.Case), o.Delimiter)
+		m := value
+		for _, part := range path[:len(path)-1] {
+			child, ok := m[part].(map[string]interface{})
+			if !ok {
+				child = map[string]interface{}{}
+				m[part] = child
+			}
+			m = child
+		}
+		last := path[len(path)-1]
+		if _, ok := m[last].(map[string]interface{}); !ok {
+			m[last] = vars[k]
+		}
+	}
+	return value
+}
+
+func envCase(s string, c EnvCase) string {
+	switch c {
+	case EnvLower:
+		return strings.ToLower(s)
+	case EnvUpper:
+		return strings.ToUpper(s)
+	}
+	return s
+}
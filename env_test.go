@@ -0,0 +1,52 @@
+package value
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFromEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		prefix  string
+		environ []string
+		options EnvOptions
+		want    map[string]interface{}
+	}{
+		{"prefix stripped", "APP", []string{"APP_PORT=80", "OTHER=1", "APPLE=2"}, EnvOptions{}, map[string]interface{}{"port": "80"}},
+		{"prefix with underscore", "APP_", []string{"APP_PORT=80"}, EnvOptions{}, map[string]interface{}{"port": "80"}},
+		{"nesting", "APP", []string{"APP_DB__HOST=x", "APP_DB__PORT=5432", "APP_MAX_CONNS=10"}, EnvOptions{},
+			map[string]interface{}{"db": map[string]interface{}{"host": "x", "port": "5432"}, "max_conns": "10"}},
+		{"deep nesting", "APP", []string{"APP_A__B__C=1"}, EnvOptions{},
+			map[string]interface{}{"a": map[string]interface{}{"b": map[string]interface{}{"c": "1"}}}},
+		{"lower case", "APP", []string{"APP_Db__Host=x"}, EnvOptions{}, map[string]interface{}{"db": map[string]interface{}{"host": "x"}}},
+		{"upper case", "APP", []string{"APP_db__host=x"}, EnvOptions{Case: EnvUpper}, map[string]interface{}{"DB": map[string]interface{}{"HOST": "x"}}},
+		{"keep case", "APP", []string{"APP_Db__Host=x"}, EnvOptions{Case: EnvKeep}, map[string]interface{}{"Db": map[string]interface{}{"Host": "x"}}},
+		{"delimiter", "APP", []string{"APP_DB_HOST=x"}, EnvOptions{Delimiter: "_"}, map[string]interface{}{"db": map[string]interface{}{"host": "x"}}},
+		{"leaf and parent", "APP", []string{"APP_DB=flat", "APP_DB__HOST=x"}, EnvOptions{}, map[string]interface{}{"db": map[string]interface{}{"host": "x"}}},
+		{"parent and leaf", "APP", []string{"APP_DB__HOST=x", "APP_DB=flat"}, EnvOptions{}, map[string]interface{}{"db": map[string]interface{}{"host": "x"}}},
+		{"value with equals", "APP", []string{"APP_DSN=a=b"}, EnvOptions{}, map[string]interface{}{"dsn": "a=b"}},
+		{"empty value", "APP", []string{"APP_EMPTY="}, EnvOptions{}, map[string]interface{}{"empty": ""}},
+		{"prefix only", "APP", []string{"APP_=1", "APP=2"}, EnvOptions{}, map[string]interface{}{}},
+		{"no prefix", "", []string{"A=1", "B__C=2"}, EnvOptions{}, map[string]interface{}{"a": "1", "b": map[string]interface{}{"c": "2"}}},
+		{"empty environ", "APP", []string{}, EnvOptions{}, map[string]interface{}{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			o := test.options
+			o.Environ = test.environ
+			if got := FromEnv(test.prefix, o); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("FromEnv(%q) = %v, want %v", test.prefix, got, test.want)
+			}
+		})
+	}
+}
+
+func TestFromEnvOS(t *testing.T) {
+	t.Setenv("VALUE_TEST_DB__HOST", "x")
+	got := New(FromEnv("VALUE_TEST")).MapGet("db.host").String()
+	if got != "x" {
+		t.Errorf(`FromEnv("VALUE_TEST") db.host = %q, want "x"`, got)
+	}
+}
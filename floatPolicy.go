@@ -0,0 +1,94 @@
+package value
+
+import (
+	"errors"
+	"math"
+)
+
+// SpecialFloatPolicy defines how special float values (NaN, ±Inf, negative zero) are converted
+type SpecialFloatPolicy int
+
+// Special float policies
+const (
+	// SpecialFloatAllow keeps value as is where target type can represent it.
+	// Integer targets can't represent NaN and ±Inf, so they still return ErrNaN or ErrInf.
+	SpecialFloatAllow SpecialFloatPolicy = iota
+	// SpecialFloatReject returns ErrNaN, ErrInf or ErrNegativeZero
+	SpecialFloatReject
+	// SpecialFloatZero maps value to zero (null in JSON)
+	SpecialFloatZero
+)
+
+// Policies applied to special float values by numeric, string and JSON conversions,
+// use Converter to apply other policies per call.
+// Defaults keep float values as they are, only "NaN" and "Inf" strings are rejected, see SpecialFloatStrings.
+var (
+	NaNPolicy          = SpecialFloatAllow
+	InfPolicy          = SpecialFloatAllow
+	NegativeZeroPolicy = SpecialFloatAllow
+)
+
+// SpecialFloatStrings allows float conversions to parse strings like "NaN", "Inf" and "-Infinity",
+// parsed values are then subject to NaNPolicy and InfPolicy. By default such strings return ErrNaN or ErrInf.
+var SpecialFloatStrings = false
+
+// Special float errors
+var (
+	ErrNaN          = errors.New("unable to cast NaN value")
+	ErrInf          = errors.New("unable to cast infinite value")
+	ErrNegativeZero = errors.New("unable to cast negative zero value")
+)
+
+// specialFloat applies NaNPolicy, InfPolicy and NegativeZeroPolicy of c to f.
+// zero is true when value was mapped to zero by SpecialFloatZero policy.
+func (c Converter) specialFloat(f float64) (value float64, zero bool, err error) {
+	policy, e := SpecialFloatAllow, error(nil)
+	switch {
+	case math.IsNaN(f):
+		policy, e = c.NaNPolicy, ErrNaN
+	case math.IsInf(f, 0):
+		policy, e = c.InfPolicy, ErrInf
+	case f == 0 && math.Signbit(f):
+		policy, e = c.NegativeZeroPolicy, ErrNegativeZero
+	default:
+		return f, false, nil
+	}
+
+	switch policy {
+	case SpecialFloatReject:
+		return 0, false, e
+	case SpecialFloatZero:
+		return 0, true, nil
+	}
+	return f, false, nil
+}
+
+// specialFloatString applies special float policies to f parsed from string,
+// NaN and ±Inf are rejected unless SpecialFloatStrings of c is set
+func (c Converter) specialFloatString(f float64) (float64, error) {
+	if !c.SpecialFloatStrings {
+		if math.IsNaN(f) {
+			return 0, ErrNaN
+		}
+		if math.IsInf(f, 0) {
+			return 0, ErrInf
+		}
+	}
+	f, _, err := c.specialFloat(f)
+	return f, err
+}
+
+// specialFloatForInt applies special float policies to f before cast to an integer type
+func (c Converter) specialFloatForInt(f float64) (float64, error) {
+	f, _, err := c.specialFloat(f)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(f) {
+		return 0, ErrNaN
+	}
+	if math.IsInf(f, 0) {
+		return 0, ErrInf
+	}
+	return f, nil
+}
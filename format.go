@@ -0,0 +1,352 @@
+package value
+
+import (
+	"encoding"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Format implements the fmt.Formatter interface.
+//
+//	%v, %s  string form of value
+//	%+v     string form with dynamic type, for example int(25)
+//	%#v     Go syntax, for example value.New(25)
+//	%q      quoted string form
+//
+// Other verbs are applied to the wrapped value.
+func (v Value) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		switch {
+		case f.Flag('#'):
+			fmt.Fprintf(f, "value.New(%#v)", v.value)
+		case f.Flag('+'):
+			if v.value == nil {
+				io.WriteString(f, "<nil>")
+				return
+			}
+			if s, ok := v.value.(string); ok {
+				fmt.Fprintf(f, "%T(%q)", v.value, s)
+				return
+			}
+			fmt.Fprintf(f, "%T(%s)", v.value, v.formatString())
+		default:
+			io.WriteString(f, v.formatString())
+		}
+	case 's':
+		io.WriteString(f, v.formatString())
+	case 'q':
+		io.WriteString(f, strconv.Quote(v.formatString()))
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), v.value)
+	}
+}
+
+// formatString returns string form of value, values not convertible to string are formatted by fmt
+func (v Value) formatString() string {
+	if v.value == nil {
+		return "<nil>"
+	}
+	s, err := ToStringE(v.value)
+	if err != nil {
+		return fmt.Sprint(v.value)
+	}
+	return s
+}
+
+// DumpOptions configure Dump output, zero fields are taken from DefaultDumpOptions
+type DumpOptions struct {
+	// MaxDepth of nested maps, slices, structs and pointers, deeper levels are shown as {…}
+	MaxDepth int
+	// MaxItems shown of each map, slice or struct
+	MaxItems int
+	// MaxStringLen of strings, longer strings are truncated
+	MaxStringLen int
+	// Indent for each nested level
+	Indent string
+}
+
+// DefaultDumpOptions are used by Dump when options are not provided
+var DefaultDumpOptions = DumpOptions{
+	MaxDepth:     10,
+	MaxItems:     100,
+	MaxStringLen: 200,
+	Indent:       "  ",
+}
+
+func (o DumpOptions) defaults() DumpOptions {
+	if o.MaxDepth == 0 {
+		o.MaxDepth = DefaultDumpOptions.MaxDepth
+	}
+	if o.MaxItems == 0 {
+		o.MaxItems = DefaultDumpOptions.MaxItems
+	}
+	if o.MaxStringLen == 0 {
+		o.MaxStringLen = DefaultDumpOptions.MaxStringLen
+	}
+	if o.Indent == "" {
+		o.Indent = DefaultDumpOptions.Indent
+	}
+	return o
+}
+
+// Dump writes pretty printed tree of value with types to w.
+// Recursive references are shown as <cycle T>.
+func (v Value) Dump(w io.Writer, options ...DumpOptions) error {
+	return Dump(w, v, options...)
+}
+
+// Dump writes pretty printed tree of i with types to w.
+func Dump(w io.Writer, i interface{}, options ...DumpOptions) error {
+	d := dumper{w: w, options: DefaultDumpOptions, visiting: map[dumpRef]bool{}}
+	if len(options) > 0 {
+		d.options = options[0].defaults()
+	}
+	d.dump(reflect.ValueOf(i), 0)
+	d.write("\n")
+	return d.err
+}
+
+var valueType = reflect.TypeOf(Value{})
+var orderedMapType = reflect.TypeOf(&OrderedMap{})
+
+type dumpRef struct {
+	t reflect.Type
+	p uintptr
+}
+
+type dumper struct {
+	w        io.Writer
+	options  DumpOptions
+	visiting map[dumpRef]bool
+	err      error
+}
+
+func (d *dumper) write(s string) {
+	if d.err == nil {
+		_, d.err = io.WriteString(d.w, s)
+	}
+}
+
+func (d *dumper) newline(depth int) {
+	d.write("\n" + strings.Repeat(d.options.Indent, depth))
+}
+
+func (d *dumper) dump(v reflect.Value, depth int) {
+	if !v.IsValid() {
+		d.write("<nil>")
+		return
+	}
+	if v.Type() == valueType {
+		d.write("value.Value → ")
+		if v.CanInterface() {
+			// keep the wrapped value interfaceable for Stringer, error and TextMarshaler
+			d.dump(reflect.ValueOf(v.Interface().(Value).value), depth)
+		} else {
+			d.dump(v.Field(0), depth)
+		}
+		return
+	}
+	if v.Type() == orderedMapType && !v.IsNil() {
+		d.dumpOrderedMap(v, depth)
+		return
+	}
+
+	t := v.Type().String()
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			d.write("<nil>")
+			return
+		}
+		d.dump(v.Elem(), depth)
+	case reflect.Ptr:
+		if v.IsNil() {
+			d.write("(" + t + ")(nil)")
+			return
+		}
+		if text, ok := d.text(v); ok && v.Elem().Type() != valueType {
+			d.write(t + " " + text)
+			return
+		}
+		if d.enter(v) {
+			return
+		}
+		d.write("&")
+		d.dump(v.Elem(), depth)
+		d.leave(v)
+	case reflect.Map:
+		if v.IsNil() {
+			d.write(t + "(nil)")
+			return
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return d.scalar(keys[i]) < d.scalar(keys[j])
+		})
+		d.container(v, t, "{", "}", len(keys), depth, func(i int) {
+			d.write(d.scalar(keys[i]) + ": ")
+			d.dump(v.MapIndex(keys[i]), depth+1)
+		})
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			d.write(t + "(nil)")
+			return
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 && v.Kind() == reflect.Slice {
+			d.write(t + " (" + strconv.Itoa(v.Len()) + ") " + d.truncate(string(v.Bytes())))
+			return
+		}
+		d.container(v, t, "[", "]", v.Len(), depth, func(i int) {
+			d.write(strconv.Itoa(i) + ": ")
+			d.dump(v.Index(i), depth+1)
+		})
+	case reflect.Struct:
+		if text, ok := d.text(v); ok {
+			d.write(t + " " + text)
+			return
+		}
+		d.container(v, t, "{", "}", v.NumField(), depth, func(i int) {
+			d.write(v.Type().Field(i).Name + ": ")
+			d.dump(v.Field(i), depth+1)
+		})
+	default:
+		d.write(t + " " + d.scalar(v))
+	}
+}
+
+// text formats structs and pointers implementing error, fmt.Stringer or encoding.TextMarshaler
+func (d *dumper) text(v reflect.Value) (string, bool) {
+	if !v.CanInterface() {
+		return "", false
+	}
+	switch s := v.Interface().(type) {
+	case error:
+		return s.Error(), true
+	case fmt.Stringer:
+		return s.String(), true
+	case encoding.TextMarshaler:
+		if text, err := s.MarshalText(); err == nil {
+			return string(text), true
+		}
+	}
+	return "", false
+}
+
+// dumpOrderedMap writes *OrderedMap items in insertion order
+func (d *dumper) dumpOrderedMap(v reflect.Value, depth int) {
+	keys, values := v.Elem().Field(0), v.Elem().Field(1)
+	if d.enter(v) {
+		return
+	}
+	item := func(i int) reflect.Value { return values.MapIndex(keys.Index(i)) }
+	if v.CanInterface() {
+		// values read through unexported fields are not interfaceable
+		m := v.Interface().(*OrderedMap)
+		item = func(i int) reflect.Value { return reflect.ValueOf(m.values[m.keys[i]]) }
+	}
+	d.container(values, v.Type().String(), "{", "}", keys.Len(), depth, func(i int) {
+		d.write(d.scalar(keys.Index(i)) + ": ")
+		d.dump(item(i), depth+1)
+	})
+	d.leave(v)
+}
+
+// container writes items of map, slice, array or struct between open and close
+func (d *dumper) container(v reflect.Value, t string, open, close string, n int, depth int, item func(i int)) {
+	header := t
+	if v.Kind() != reflect.Struct {
+		header += " (" + strconv.Itoa(n) + ")"
+	}
+	if n == 0 {
+		d.write(header + " " + open + close)
+		return
+	}
+	if depth >= d.options.MaxDepth {
+		d.write(header + " " + open + "…" + close)
+		return
+	}
+	if v.Kind() != reflect.Struct && d.enter(v) {
+		return
+	}
+	d.write(header + " " + open)
+	for i := 0; i < n; i++ {
+		if i >= d.options.MaxItems {
+			d.newline(depth + 1)
+			d.write("… " + strconv.Itoa(n-i) + " more")
+			break
+		}
+		d.newline(depth + 1)
+		item(i)
+	}
+	d.newline(depth)
+	d.write(close)
+	if v.Kind() != reflect.Struct {
+		d.leave(v)
+	}
+}
+
+// enter marks reference as being dumped, returns true and writes cycle marker if it already is
+func (d *dumper) enter(v reflect.Value) bool {
+	if v.Kind() == reflect.Array {
+		return false
+	}
+	ref := dumpRef{v.Type(), v.Pointer()}
+	if d.visiting[ref] {
+		d.write("<cycle " + v.Type().String() + ">")
+		return true
+	}
+	d.visiting[ref] = true
+	return false
+}
+
+func (d *dumper) leave(v reflect.Value) {
+	if v.Kind() != reflect.Array {
+		delete(d.visiting, dumpRef{v.Type(), v.Pointer()})
+	}
+}
+
+// scalar formats basic kinds, other kinds are formatted by fmt when possible
+func (d *dumper) scalar(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return "<nil>"
+		}
+		return d.scalar(v.Elem())
+	case reflect.String:
+		return d.truncate(v.String())
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	case reflect.Complex64:
+		return strconv.FormatComplex(v.Complex(), 'g', -1, 64)
+	case reflect.Complex128:
+		return strconv.FormatComplex(v.Complex(), 'g', -1, 128)
+	}
+	if v.CanInterface() {
+		return fmt.Sprint(v.Interface())
+	}
+	return v.Type().String()
+}
+
+// truncate quotes s and cuts it to MaxStringLen runes
+func (d *dumper) truncate(s string) string {
+	if d.options.MaxStringLen > 0 && utf8.RuneCountInString(s) > d.options.MaxStringLen {
+		r := []rune(s)
+		return strconv.Quote(string(r[:d.options.MaxStringLen])) + "… (" + strconv.Itoa(len(r)) + " runes)"
+	}
+	return strconv.Quote(s)
+}
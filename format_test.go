@@ -0,0 +1,47 @@
+package value
+
+import (
+	"bytes"
+	"errors"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+)
+
+type dumpText struct{ s string }
+
+func (t dumpText) MarshalText() ([]byte, error) { return []byte(t.s), nil }
+
+func TestDump(t *testing.T) {
+	now := time.Now()
+	addr := netip.MustParseAddr("10.0.0.1")
+	ordered := NewOrderedMap()
+	ordered.Set("at", now)
+
+	tests := []struct {
+		name  string
+		input interface{}
+		want  string
+	}{
+		{"time", New(now), "value.Value → time.Time " + now.String()},
+		{"netip", New(addr), "value.Value → netip.Addr 10.0.0.1"},
+		{"error", New(errors.New("failed")), "value.Value → *errors.errorString failed"},
+		{"text marshaler", New(dumpText{"text"}), "value.Value → value.dumpText text"},
+		{"nested value", New(map[string]Value{"at": New(now)}), `"at": value.Value → time.Time ` + now.String()},
+		{"ordered map", New(ordered), `"at": time.Time ` + now.String()},
+		{"nil", New(nil), "value.Value → <nil>"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Dump(&buf, test.input); err != nil {
+				t.Fatalf("Dump() error = %v", err)
+			}
+			if got := buf.String(); !strings.Contains(got, test.want) {
+				t.Errorf("Dump() = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
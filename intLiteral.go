@@ -0,0 +1,256 @@
+package value
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Integer literal grammar shared by all integer conversions (ToIntE, ToInt64E, ..., ToUintE, ToUint64E, ...):
+//
+//	literal = [ whitespace ] [ "+" | "-" ] digits [ whitespace ]
+//	digits  = decimal | "0x" hex | "0X" hex | "0o" octal | "0O" octal | "0" octal | "0b" binary | "0B" binary
+//
+// Digits may be separated by single underscores as in Go source ("1_000", "0x_1F").
+// Accepted bases are restricted with IntLiteralBases.
+// Negative values are rejected by unsigned targets with errNegativeNotAllowed ("-0" is allowed),
+// values not fitting target size are rejected with strconv.ErrRange.
+// Decimal strings with a fraction or exponent ("12.0", "1e3") are parsed as floats and rounded with IntRounding,
+// prefixed and leading zero forms ("0x1p4", "09", "01.5") are syntax errors.
+
+// IntBase is a set of bases accepted in integer literals
+type IntBase int
+
+// Integer literal bases
+const (
+	BaseDecimal IntBase = 1 << iota
+	BaseHex
+	BaseOctal
+	BaseBinary
+
+	AllBases = BaseDecimal | BaseHex | BaseOctal | BaseBinary
+)
+
+// IntLiteralBases are the bases accepted by integer conversions of strings, use Converter to accept other bases per call
+var IntLiteralBases = AllBases
+
+// ErrBaseNotAllowed is returned when integer literal base is not in IntLiteralBases
+var ErrBaseNotAllowed = errors.New("integer literal base not allowed")
+
+// parseIntLiteral parses integer literal into sign and magnitude, bases are restricted by IntLiteralBases of c
+func (c Converter) parseIntLiteral(s string) (negative bool, magnitude uint64, err error) {
+	s = strings.TrimSpace(s)
+	literal := s
+	if s != "" && (s[0] == '+' || s[0] == '-') {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+
+	base := BaseDecimal
+	if len(s) > 1 && s[0] == '0' {
+		switch s[1] {
+		case 'x', 'X':
+			base = BaseHex
+		case 'b', 'B':
+			base = BaseBinary
+		case 'o', 'O':
+			base = BaseOctal
+		default:
+			if s[1] >= '0' && s[1] <= '9' || s[1] == '_' {
+				base = BaseOctal
+			}
+		}
+	}
+	if c.intLiteralBases()&base == 0 {
+		return false, 0, &strconv.NumError{Func: "ParseInt", Num: literal, Err: ErrBaseNotAllowed}
+	}
+
+	// sign is already consumed, so nested sign like "+-5" is a syntax error
+	if s != "" && (s[0] == '+' || s[0] == '-') {
+		return false, 0, &strconv.NumError{Func: "ParseInt", Num: literal, Err: strconv.ErrSyntax}
+	}
+	magnitude, err = strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		err = &strconv.NumError{Func: "ParseInt", Num: literal, Err: errors.Unwrap(err)}
+	}
+	return
+}
+
+// isFloatLiteral reports whether s is a decimal float with a fraction or exponent, or a NaN or Inf name.
+// Base prefixes and leading zeros are left to the integer grammar.
+func isFloatLiteral(s string) bool {
+	s = strings.TrimSpace(s)
+	if s != "" && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+	switch strings.ToLower(s) {
+	case "nan", "inf", "infinity":
+		return true
+	}
+	if len(s) > 1 && s[0] == '0' && s[1] != '.' && s[1] != 'e' && s[1] != 'E' {
+		return false
+	}
+	return strings.ContainsAny(s, ".eE")
+}
+
+// parseIntString parses s by integer literal grammar into a signed integer of bitSize bits (0 for int)
+func (c Converter) parseIntString(s string, bitSize int) (int64, error) {
+	if bitSize == 0 {
+		bitSize = strconv.IntSize
+	}
+	negative, magnitude, err := c.parseIntLiteral(s)
+	if err != nil {
+		if !errors.Is(err, strconv.ErrSyntax) {
+			return 0, err
+		}
+		if !isFloatLiteral(s) {
+			return 0, err
+		}
+		f, e := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if e != nil {
+			return 0, err
+		}
+		if f, e = c.specialFloatString(f); e != nil {
+			return 0, e
+		}
+		v, e := c.floatToInt64(f, 64)
+		if e != nil {
+			return 0, e
+		}
+		negative, magnitude = v < 0, uint64(v)
+		if negative {
+			magnitude = uint64(-v)
+		}
+	}
+
+	limit := uint64(1) << uint(bitSize-1)
+	if negative && magnitude > limit || !negative && magnitude >= limit {
+		return 0, &strconv.NumError{Func: "ParseInt", Num: s, Err: strconv.ErrRange}
+	}
+	if negative {
+		return -int64(magnitude), nil
+	}
+	return int64(magnitude), nil
+}
+
+// parseUintString parses s by integer literal grammar into an unsigned integer of bitSize bits (0 for uint)
+func (c Converter) parseUintString(s string, bitSize int) (uint64, error) {
+	if bitSize == 0 {
+		bitSize = strconv.IntSize
+	}
+	negative, magnitude, err := c.parseIntLiteral(s)
+	if err != nil {
+		if !errors.Is(err, strconv.ErrSyntax) {
+			return 0, err
+		}
+		if !isFloatLiteral(s) {
+			return 0, err
+		}
+		f, e := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if e != nil {
+			return 0, err
+		}
+		if f, e = c.specialFloatString(f); e != nil {
+			return 0, e
+		}
+		magnitude, e = c.floatToUint64(f, 64)
+		if e != nil {
+			return 0, e
+		}
+	}
+
+	if negative && magnitude != 0 {
+		return 0, errNegativeNotAllowed
+	}
+	if bitSize < 64 && magnitude > 1<<uint(bitSize)-1 {
+		return 0, &strconv.NumError{Func: "ParseUint", Num: s, Err: strconv.ErrRange}
+	}
+	return magnitude, nil
+}
+
+// IntFormat describes how FormatInt formats integers
+type IntFormat struct {
+	// Base from 2 to 36, 10 when zero
+	Base int
+	// Prefix adds "0b", "0o" or "0x" for bases 2, 8 and 16, so output can be parsed back by integer conversions
+	Prefix bool
+	// Upper uses upper case letters for digits above 9
+	Upper bool
+	// Width is minimum length of result, shorter results are padded with Pad
+	Width int
+	// Pad is padding character, '0' when zero. Zeros are inserted after sign and prefix, other characters before them.
+	Pad rune
+}
+
+// FormatIntE casts an interface to an integer and formats it as string by format.
+func FormatIntE(i interface{}, format IntFormat) (value string, err error) {
+	if v, ok := i.(Value); ok {
+		i = v.value
+	}
+	i = indirect(i)
+	base := format.Base
+	if base == 0 {
+		base = 10
+	}
+	if base < 2 || base > 36 {
+		err = errors.New("invalid base " + strconv.Itoa(base))
+		return
+	}
+
+	sign, digits := "", ""
+	switch i.(type) {
+	case uint, uint64, uint32, uint16, uint8:
+		u, e := ToUint64E(i)
+		if e != nil {
+			err = e
+			return
+		}
+		digits = strconv.FormatUint(u, base)
+	default:
+		n, e := ToInt64E(i)
+		if e != nil {
+			err = e
+			return
+		}
+		if n < 0 {
+			sign = "-"
+			digits = strconv.FormatUint(uint64(-(n+1))+1, base)
+		} else {
+			digits = strconv.FormatUint(uint64(n), base)
+		}
+	}
+	if format.Upper {
+		digits = strings.ToUpper(digits)
+	}
+
+	prefix := ""
+	if format.Prefix {
+		switch base {
+		case 2:
+			prefix = "0b"
+		case 8:
+			prefix = "0o"
+		case 16:
+			prefix = "0x"
+		}
+	}
+
+	pad := format.Pad
+	if pad == 0 {
+		pad = '0'
+	}
+	if n := format.Width - len(sign) - len(prefix) - len(digits); n > 0 {
+		padding := strings.Repeat(string(pad), n)
+		if pad == '0' {
+			digits = padding + digits
+		} else {
+			sign = padding + sign
+		}
+	}
+	value = sign + prefix + digits
+	return
+}
+func FormatInt(i interface{}, format IntFormat) string {
+	v, _ := FormatIntE(i, format)
+	return v
+}
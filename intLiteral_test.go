@@ -0,0 +1,171 @@
+package value
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestIntLiteralGrammar(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int64
+		err   error
+	}{
+		{"42", 42, nil},
+		{" 42 ", 42, nil},
+		{"+42", 42, nil},
+		{"-42", -42, nil},
+		{"1_000", 1000, nil},
+		{"0x1F", 31, nil},
+		{"0X1f", 31, nil},
+		{"0x_1F", 31, nil},
+		{"-0x10", -16, nil},
+		{"0o17", 15, nil},
+		{"0O17", 15, nil},
+		{"017", 15, nil},
+		{"0_17", 15, nil},
+		{"0b101", 5, nil},
+		{"0B101", 5, nil},
+		{"0", 0, nil},
+		{"-0", 0, nil},
+		{"12.0", 12, nil},
+		{"1e3", 1000, nil},
+		{"-2.9", -2, nil},
+		{"9223372036854775807", 9223372036854775807, nil},
+		{"-9223372036854775808", -9223372036854775808, nil},
+		{"9223372036854775808", 0, strconv.ErrRange},
+		{"0x8000000000000000", 0, strconv.ErrRange},
+		{"", 0, strconv.ErrSyntax},
+		{"abc", 0, strconv.ErrSyntax},
+		{"+-5", 0, strconv.ErrSyntax},
+		{"1__000", 0, strconv.ErrSyntax},
+		{"_1", 0, strconv.ErrSyntax},
+		{"1_", 0, strconv.ErrSyntax},
+		{"0x", 0, strconv.ErrSyntax},
+		{"0b102", 0, strconv.ErrSyntax},
+		{"1 000", 0, strconv.ErrSyntax},
+		{"09", 0, strconv.ErrSyntax},
+		{"01.5", 0, strconv.ErrSyntax},
+		{"0x1p4", 0, strconv.ErrSyntax},
+		{"0.5e1", 5, nil},
+		{"NaN", 0, ErrNaN},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			got, err := ToInt64E(test.input)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("ToInt64E(%q) error = %v, want %v", test.input, err, test.err)
+			}
+			if got != test.want {
+				t.Errorf("ToInt64E(%q) = %d, want %d", test.input, got, test.want)
+			}
+		})
+	}
+}
+
+func TestIntLiteralTargetSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		convert func(interface{}) (interface{}, error)
+		input   string
+		want    interface{}
+		err     error
+	}{
+		{"int8 max", func(i interface{}) (interface{}, error) { return ToInt8E(i) }, "127", int8(127), nil},
+		{"int8 min", func(i interface{}) (interface{}, error) { return ToInt8E(i) }, "-0x80", int8(-128), nil},
+		{"int8 overflow", func(i interface{}) (interface{}, error) { return ToInt8E(i) }, "128", int8(0), strconv.ErrRange},
+		{"int16 overflow", func(i interface{}) (interface{}, error) { return ToInt16E(i) }, "-32769", int16(0), strconv.ErrRange},
+		{"int32 hex", func(i interface{}) (interface{}, error) { return ToInt32E(i) }, "0x7fff_ffff", int32(2147483647), nil},
+		{"uint8 max", func(i interface{}) (interface{}, error) { return ToUint8E(i) }, "0b1111_1111", uint8(255), nil},
+		{"uint8 overflow", func(i interface{}) (interface{}, error) { return ToUint8E(i) }, "256", uint8(0), strconv.ErrRange},
+		{"uint16 float", func(i interface{}) (interface{}, error) { return ToUint16E(i) }, "6.5e4", uint16(65000), nil},
+		{"uint32 negative", func(i interface{}) (interface{}, error) { return ToUint32E(i) }, "-1", uint32(0), errNegativeNotAllowed},
+		{"uint leading zero", func(i interface{}) (interface{}, error) { return ToUintE(i) }, "09", uint(0), strconv.ErrSyntax},
+		{"uint hex float", func(i interface{}) (interface{}, error) { return ToUintE(i) }, "0x1p4", uint(0), strconv.ErrSyntax},
+		{"uint negative zero", func(i interface{}) (interface{}, error) { return ToUintE(i) }, "-0", uint(0), nil},
+		{"uint64 max", func(i interface{}) (interface{}, error) { return ToUint64E(i) }, "0xffffffffffffffff", uint64(18446744073709551615), nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.convert(test.input)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("convert(%q) error = %v, want %v", test.input, err, test.err)
+			}
+			if got != test.want {
+				t.Errorf("convert(%q) = %v, want %v", test.input, got, test.want)
+			}
+		})
+	}
+}
+
+func TestIntLiteralBases(t *testing.T) {
+	tests := []struct {
+		bases IntBase
+		input string
+		want  int
+		err   error
+	}{
+		{BaseDecimal, "10", 10, nil},
+		{BaseDecimal, "0x10", 0, ErrBaseNotAllowed},
+		{BaseDecimal, "010", 0, ErrBaseNotAllowed},
+		{BaseDecimal | BaseHex, "0x10", 16, nil},
+		{BaseDecimal | BaseHex, "0b10", 0, ErrBaseNotAllowed},
+		{BaseHex, "10", 0, ErrBaseNotAllowed},
+		{AllBases, "0o10", 8, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			c := DefaultConverter()
+			c.IntLiteralBases = test.bases
+			got, err := c.ToIntE(test.input)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("ToIntE(%q) error = %v, want %v", test.input, err, test.err)
+			}
+			if got != test.want {
+				t.Errorf("ToIntE(%q) = %d, want %d", test.input, got, test.want)
+			}
+		})
+	}
+}
+
+func TestFormatInt(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		format IntFormat
+		want   string
+	}{
+		{42, IntFormat{}, "42"},
+		{-42, IntFormat{Base: 16, Prefix: true}, "-0x2a"},
+		{255, IntFormat{Base: 16, Upper: true}, "FF"},
+		{uint64(18446744073709551615), IntFormat{Base: 16}, "ffffffffffffffff"},
+		{int64(-9223372036854775808), IntFormat{Base: 2, Prefix: true}, "-0b1" + "000000000000000000000000000000000000000000000000000000000000000"},
+		{5, IntFormat{Base: 2, Prefix: true, Width: 8}, "0b000101"},
+		{-5, IntFormat{Width: 4}, "-005"},
+		{5, IntFormat{Width: 4, Pad: ' '}, "   5"},
+		{"0o17", IntFormat{Base: 8, Prefix: true}, "0o17"},
+	}
+
+	for _, test := range tests {
+		got, err := FormatIntE(test.input, test.format)
+		if err != nil {
+			t.Fatalf("FormatIntE(%v) error = %v", test.input, err)
+		}
+		if got != test.want {
+			t.Errorf("FormatIntE(%v) = %q, want %q", test.input, got, test.want)
+		}
+		// prefixed and decimal results are parsed back by integer conversions
+		if test.format.Pad == 0 && (test.format.Prefix || test.format.Base == 0) {
+			if n, err := ToInt64E(got); err != nil || FormatInt(n, test.format) != got {
+				t.Errorf("ToInt64E(%q) = %d, %v, want round trip", got, n, err)
+			}
+		}
+	}
+
+	if _, err := FormatIntE(1, IntFormat{Base: 37}); err == nil {
+		t.Errorf("FormatIntE() with base 37 error = nil, want error")
+	}
+}
@@ -0,0 +1,77 @@
+package value
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+)
+
+// MarshalJSON implements the json.Marshaler interface.
+// Special float values are encoded by NaNPolicy, InfPolicy and NegativeZeroPolicy:
+// rejected with error, encoded as null or allowed as strings "NaN", "+Inf", "-Inf".
+func (v Value) MarshalJSON() ([]byte, error) {
+	i, err := jsonSafe(v.value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(i)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Numbers are kept as json.Number, so no precision is lost, objects are decoded as *OrderedMap in document order.
+func (v *Value) UnmarshalJSON(data []byte) error {
+	i, err := decodeOrderedJSON(data)
+	if err != nil {
+		return err
+	}
+	v.value = i
+	return nil
+}
+
+// jsonSafe applies special float policies to floats in i, so it can be encoded by json.Marshal
+func jsonSafe(i interface{}) (interface{}, error) {
+	switch v := i.(type) {
+	case float64:
+		return jsonFloat(v, 64)
+	case float32:
+		return jsonFloat(float64(v), 32)
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			safe, err := jsonSafe(val)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = safe
+		}
+		return m, nil
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for j, val := range v {
+			safe, err := jsonSafe(val)
+			if err != nil {
+				return nil, err
+			}
+			s[j] = safe
+		}
+		return s, nil
+	}
+	return i, nil
+}
+
+func jsonFloat(f float64, bitSize int) (interface{}, error) {
+	f, zero, err := DefaultConverter().specialFloat(f)
+	if err != nil {
+		return nil, err
+	}
+	if zero {
+		return nil, nil
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return strconv.FormatFloat(f, 'f', -1, bitSize), nil
+	}
+	if bitSize == 32 {
+		return float32(f), nil
+	}
+	return f, nil
+}
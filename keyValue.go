@@ -0,0 +1,193 @@
+package value
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// KeyValueFormat describes delimited key, value strings like "a=1,b=2", "k1:v1;k2:v2" or "host=x port=5432".
+// Keys and values containing seperators are quoted, backslash escapes the next character inside quotes.
+type KeyValueFormat struct {
+	// PairSeperator between pairs, "," when empty. White space seperator matches any run of white space.
+	PairSeperator string
+	// KeyValueSeperator between key and value, "=" when empty
+	KeyValueSeperator string
+	// Quotes are characters starting quoted keys and values, `"'` when empty
+	Quotes string
+}
+
+var (
+	// KeyValueComma parses "a=1,b=2"
+	KeyValueComma = KeyValueFormat{PairSeperator: ",", KeyValueSeperator: "="}
+	// KeyValueSemicolon parses "k1:v1;k2:v2"
+	KeyValueSemicolon = KeyValueFormat{PairSeperator: ";", KeyValueSeperator: ":"}
+	// KeyValueSpace parses "host=x port=5432"
+	KeyValueSpace = KeyValueFormat{PairSeperator: " ", KeyValueSeperator: "="}
+)
+
+// ErrInvalidKeyValue is returned when pair has no key, value seperator or quote is not closed
+var ErrInvalidKeyValue = errors.New("invalid key value pair")
+
+func (f KeyValueFormat) defaults() KeyValueFormat {
+	if f.PairSeperator == "" {
+		f.PairSeperator = ","
+	}
+	if f.KeyValueSeperator == "" {
+		f.KeyValueSeperator = "="
+	}
+	if f.Quotes == "" {
+		f.Quotes = `"'`
+	}
+	return f
+}
+
+// ParseKeyValues parses delimited key, value string s into map of string values.
+// Empty pairs are skipped, the last value of repeated key wins.
+func ParseKeyValues(s string, f KeyValueFormat) (map[string]interface{}, error) {
+	f = f.defaults()
+	value := map[string]interface{}{}
+	pairs, err := f.split(s, f.PairSeperator, -1)
+	if err != nil {
+		return nil, err
+	}
+	for _, pair := range pairs {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		kv, err := f.split(pair, f.KeyValueSeperator, 2)
+		if err != nil {
+			return nil, err
+		}
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidKeyValue, pair)
+		}
+		value[f.unquote(kv[0])] = f.unquote(kv[1])
+	}
+	return value, nil
+}
+
+// split splits s at unquoted seperators into at most n parts, n < 0 for no limit.
+// Quote starts quoted section only at the start of key or value, so "O'Brien" is not quoted.
+func (f KeyValueFormat) split(s, seperator string, n int) ([]string, error) {
+	parts := []string{}
+	start, token, quote := 0, 0, rune(0)
+scan:
+	for j := 0; j < len(s); {
+		r, size := utf8.DecodeRuneInString(s[j:])
+		switch {
+		case quote != 0:
+			if r == '\\' && j+size < len(s) {
+				_, next := utf8.DecodeRuneInString(s[j+size:])
+				size += next
+			} else if r == quote {
+				quote = 0
+			}
+		case strings.ContainsRune(f.Quotes, r) && strings.TrimSpace(s[token:j]) == "":
+			quote = r
+		default:
+			if n < 0 || len(parts) < n-1 {
+				if m := matchSeperator(s[j:], seperator); m > 0 {
+					parts = append(parts, s[start:j])
+					j += m
+					start, token = j, j
+					continue
+				}
+			}
+			// key, value and pair seperators start new token even when s is not split at them
+			for _, sep := range []string{f.PairSeperator, f.KeyValueSeperator} {
+				if m := matchSeperator(s[j:], sep); m > 0 {
+					j += m
+					token = j
+					continue scan
+				}
+			}
+		}
+		j += size
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("%w: unclosed quote in %q", ErrInvalidKeyValue, s)
+	}
+	return append(parts, s[start:]), nil
+}
+
+// matchSeperator returns length of seperator at start of s, white space seperator matches any run of white space
+func matchSeperator(s, seperator string) int {
+	if strings.TrimSpace(seperator) == "" {
+		return len(s) - len(strings.TrimLeftFunc(s, unicode.IsSpace))
+	}
+	if strings.HasPrefix(s, seperator) {
+		return len(seperator)
+	}
+	return 0
+}
+
+// unquote trims s and removes quotes and escapes of quoted s
+func (f KeyValueFormat) unquote(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != s[len(s)-1] || !strings.ContainsRune(f.Quotes, rune(s[0])) {
+		return s
+	}
+	var b strings.Builder
+	escaped := false
+	for _, r := range s[1 : len(s)-1] {
+		if r == '\\' && !escaped {
+			escaped = true
+			continue
+		}
+		escaped = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// quote quotes s when it contains seperators, quotes or surrounding white space
+func (f KeyValueFormat) quote(s string) string {
+	if !strings.ContainsAny(s, f.Quotes) && strings.TrimSpace(s) == s &&
+		!strings.Contains(s, f.KeyValueSeperator) && !f.containsPairSeperator(s) {
+		return s
+	}
+	q, _ := utf8.DecodeRuneInString(f.Quotes)
+	var b strings.Builder
+	b.WriteRune(q)
+	for _, r := range s {
+		if r == q || r == '\\' {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteRune(q)
+	return b.String()
+}
+
+func (f KeyValueFormat) containsPairSeperator(s string) bool {
+	if strings.TrimSpace(f.PairSeperator) == "" {
+		return strings.IndexFunc(s, unicode.IsSpace) >= 0
+	}
+	return strings.Contains(s, f.PairSeperator)
+}
+
+// FormatKeyValuesE casts an interface to a map and formats it as delimited key, value string by f.
+// Keys are ordered by SortKeys, OrderedMap keeps insertion order, values are converted by ToStringE.
+func FormatKeyValuesE(i interface{}, f KeyValueFormat) (value string, err error) {
+	f = f.defaults()
+	entries, err := ToEntriesE(i)
+	if err != nil {
+		return "", fmt.Errorf("unable to format %#v of type %T as key values: %w", i, i, err)
+	}
+	pairs := make([]string, len(entries))
+	for j, e := range entries {
+		s, err := e.Value.StringE()
+		if err != nil {
+			return "", err
+		}
+		pairs[j] = f.quote(e.Key) + f.KeyValueSeperator + f.quote(s)
+	}
+	return strings.Join(pairs, f.PairSeperator), nil
+}
+func FormatKeyValues(i interface{}, f KeyValueFormat) string {
+	v, _ := FormatKeyValuesE(i, f)
+	return v
+}
@@ -0,0 +1,112 @@
+package value
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseKeyValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		format KeyValueFormat
+		want   map[string]interface{}
+		err    error
+	}{
+		{"comma", "a=1,b=2", KeyValueComma, map[string]interface{}{"a": "1", "b": "2"}, nil},
+		{"defaults", "a=1,b=2", KeyValueFormat{}, map[string]interface{}{"a": "1", "b": "2"}, nil},
+		{"semicolon", "k1:v1;k2:v2", KeyValueSemicolon, map[string]interface{}{"k1": "v1", "k2": "v2"}, nil},
+		{"space", "host=x   port=5432", KeyValueSpace, map[string]interface{}{"host": "x", "port": "5432"}, nil},
+		{"trimmed", " a = 1 , b = 2 ", KeyValueComma, map[string]interface{}{"a": "1", "b": "2"}, nil},
+		{"empty pairs", "a=1,,b=2,", KeyValueComma, map[string]interface{}{"a": "1", "b": "2"}, nil},
+		{"empty value", "a=,b=2", KeyValueComma, map[string]interface{}{"a": "", "b": "2"}, nil},
+		{"repeated key", "a=1,a=2", KeyValueComma, map[string]interface{}{"a": "2"}, nil},
+		{"value with seperator", "a=b=c", KeyValueComma, map[string]interface{}{"a": "b=c"}, nil},
+		{"quoted", `a="x,y",b='c=d'`, KeyValueComma, map[string]interface{}{"a": "x,y", "b": "c=d"}, nil},
+		{"quoted key", `"a,b"=1`, KeyValueComma, map[string]interface{}{"a,b": "1"}, nil},
+		{"escaped", `a="say \"hi\""`, KeyValueComma, map[string]interface{}{"a": `say "hi"`}, nil},
+		{"quoted space", `name='a b' port=1`, KeyValueSpace, map[string]interface{}{"name": "a b", "port": "1"}, nil},
+		{"apostrophe", "name=O'Brien,x=1", KeyValueComma, map[string]interface{}{"name": "O'Brien", "x": "1"}, nil},
+		{"apostrophe in key", "it's=ok", KeyValueComma, map[string]interface{}{"it's": "ok"}, nil},
+		{"empty", "", KeyValueComma, map[string]interface{}{}, nil},
+		{"missing seperator", "a=1,b", KeyValueComma, nil, ErrInvalidKeyValue},
+		{"unclosed quote", `a="x`, KeyValueComma, nil, ErrInvalidKeyValue},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseKeyValues(test.input, test.format)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("ParseKeyValues(%q) error = %v, want %v", test.input, err, test.err)
+			}
+			if test.err == nil && !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ParseKeyValues(%q) = %v, want %v", test.input, got, test.want)
+			}
+		})
+	}
+}
+
+func TestFormatKeyValues(t *testing.T) {
+	ordered := NewOrderedMap()
+	ordered.Set("z", 1)
+	ordered.Set("a", true)
+
+	tests := []struct {
+		name   string
+		input  interface{}
+		format KeyValueFormat
+		want   string
+	}{
+		{"sorted", map[string]int{"b": 2, "a": 1}, KeyValueComma, "a=1,b=2"},
+		{"ordered", ordered, KeyValueComma, "z=1,a=true"},
+		{"semicolon", map[string]string{"k1": "v1", "k2": "v2"}, KeyValueSemicolon, "k1:v1;k2:v2"},
+		{"space", map[string]interface{}{"host": "x", "port": 5432}, KeyValueSpace, "host=x port=5432"},
+		{"quoted seperators", map[string]string{"a": "x,y", "b=c": "d"}, KeyValueComma, `a="x,y","b=c"=d`},
+		{"quoted quotes", map[string]string{"a": `say "hi"`, "b": "O'Brien"}, KeyValueComma, `a="say \"hi\"",b="O'Brien"`},
+		{"quoted white space", map[string]string{"a": " x", "b": "c d"}, KeyValueSpace, `a=" x" b="c d"`},
+		{"empty", map[string]string{}, KeyValueComma, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := FormatKeyValuesE(test.input, test.format)
+			if err != nil {
+				t.Fatalf("FormatKeyValuesE(%v) error = %v", test.input, err)
+			}
+			if got != test.want {
+				t.Errorf("FormatKeyValuesE(%v) = %s, want %s", test.input, got, test.want)
+			}
+		})
+	}
+
+	if _, err := FormatKeyValuesE(42, KeyValueComma); err == nil {
+		t.Errorf("FormatKeyValuesE(42) error = nil, want error")
+	}
+}
+
+func TestKeyValuesRoundTrip(t *testing.T) {
+	inputs := []map[string]interface{}{
+		{"a": "1", "b": "2"},
+		{"a": "x,y", "b=c": "d", "e": ""},
+		{"quote": `say "hi"`, "name": "O'Brien", "path": `c:\dir`},
+		{"space": " padded ", "words": "a b c"},
+	}
+	formats := []KeyValueFormat{KeyValueComma, KeyValueSemicolon, KeyValueSpace}
+
+	for _, input := range inputs {
+		for _, format := range formats {
+			s, err := FormatKeyValuesE(input, format)
+			if err != nil {
+				t.Fatalf("FormatKeyValuesE(%v) error = %v", input, err)
+			}
+			got, err := ParseKeyValues(s, format)
+			if err != nil {
+				t.Fatalf("ParseKeyValues(%q) error = %v", s, err)
+			}
+			if !reflect.DeepEqual(got, input) {
+				t.Errorf("ParseKeyValues(FormatKeyValuesE(%v)) = %v via %q", input, got, s)
+			}
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package value
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// SortKeys orders map keys in map to slice conversions and Entries, lexically by default.
+// Converter.ToEntriesE applies other order per call.
+var SortKeys = sort.Strings
+
+// KeyValue is a map entry
+type KeyValue struct {
+	Key   string
+	Value Value
+}
+
+// sortedKeys returns keys of m ordered by SortKeys
+func sortedKeys[T any](m map[string]T) []string {
+	return sortedKeysBy(m, SortKeys)
+}
+
+// sortedKeysBy returns keys of m ordered by sortKeys
+func sortedKeysBy[T any](m map[string]T, sortKeys func([]string)) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sortKeys(keys)
+	return keys
+}
+
+// ToEntriesE casts an interface to a []KeyValue type ordered by SortKeys, OrderedMap keeps insertion order.
+// Accepts everything ToValueMapE accepts.
+func ToEntriesE(i interface{}) (value []KeyValue, err error) {
+	return DefaultConverter().ToEntriesE(i)
+}
+
+// ToEntriesE casts an interface to a []KeyValue type ordered by SortKeys of c, OrderedMap keeps insertion order.
+func (c Converter) ToEntriesE(i interface{}) (value []KeyValue, err error) {
+	value = []KeyValue{}
+	if v, ok := i.(Value); ok {
+		i = v.value
+	}
+	if m, ok := i.(*OrderedMap); ok {
+		value = m.Entries()
+		return
+	}
+	m, err := ToValueMapE(i)
+	if err != nil {
+		return
+	}
+	for _, k := range sortedKeysBy(m, c.sortKeys()) {
+		value = append(value, KeyValue{Key: k, Value: m[k]})
+	}
+	return
+}
+func ToEntries(i interface{}) []KeyValue {
+	v, _ := ToEntriesE(i)
+	return v
+}
+
+// MapOptions configure map conversions
+type MapOptions struct {
+	// Copy returns fresh map instead of the input map[string]interface{} or map[string]Value
+	Copy bool
+	// Tag is struct field tag used for keys, "json" when empty.
+	// Tag name "-" skips field, option "omitempty" skips zero values.
+	Tag string
+	// KeyValue parses strings which are not JSON objects as delimited key, value pairs, see ParseKeyValues
+	KeyValue *KeyValueFormat
+}
+
+// stringToMap decodes JSON object s, or key, value pairs when o.KeyValue is set and s is not JSON object
+func stringToMap(s string, o MapOptions) (map[string]interface{}, error) {
+	if o.KeyValue != nil && !strings.HasPrefix(strings.TrimSpace(s), "{") {
+		return ParseKeyValues(s, *o.KeyValue)
+	}
+	m := map[string]interface{}{}
+	err := json.Unmarshal([]uint8(s), &m)
+	return m, err
+}
+
+func mapOptions(options []MapOptions) MapOptions {
+	o := MapOptions{}
+	if len(options) > 0 {
+		o = options[0]
+	}
+	if o.Tag == "" {
+		o.Tag = "json"
+	}
+	return o
+}
+
+// reflectMap puts keys and values of any map kind, OrderedMap or struct i into m.
+// Structs without exported fields are rejected.
+func reflectMap(i interface{}, o MapOptions, m map[string]interface{}) error {
+	if om, ok := i.(OrderedMap); ok {
+		i = &om
+	}
+	if om, ok := i.(*OrderedMap); ok {
+		for k, val := range om.Map() {
+			m[k] = val
+		}
+		return nil
+	}
+	v := reflect.ValueOf(i)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			k, err := ToStringE(iter.Key().Interface())
+			if err != nil {
+				return err
+			}
+			m[k] = iter.Value().Interface()
+		}
+		return nil
+	case reflect.Struct:
+		if structToMap(v, o.Tag, m) == 0 {
+			return errors.New("struct has no exported fields")
+		}
+		return nil
+	}
+	return errors.New("not a map or struct")
+}
+
+// structToMap puts exported fields of struct v into m, embedded structs without tag name are flattened.
+// Returns number of exported fields.
+func structToMap(v reflect.Value, tag string, m map[string]interface{}) (exported int) {
+	t := v.Type()
+	for j := 0; j < t.NumField(); j++ {
+		f := t.Field(j)
+		name, opts, _ := strings.Cut(f.Tag.Get(tag), ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+		fv := v.Field(j)
+		if f.Anonymous && name == "" {
+			for fv.Kind() == reflect.Ptr && !fv.IsNil() {
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct {
+				exported += structToMap(fv, tag, m)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		exported++
+		if name == "" {
+			name = f.Name
+		}
+		if fv.IsZero() && strings.Contains(","+opts+",", ",omitempty,") {
+			continue
+		}
+		m[name] = fv.Interface()
+	}
+	return
+}
+
+// toStringMapE casts an interface to a map[string]T type converting each value with convert.
+// Any map kind is accepted with keys converted by ToStringE, structs are converted by field tags.
+// Strings are decoded as JSON objects.
+func toStringMapE[T any](i interface{}, convert func(interface{}) (T, error)) (value map[string]T, err error) {
+	if v, ok := i.(Value); ok {
+		i = v.value
+	}
+	if v, ok := i.([]uint8); ok {
+		i = string(v)
+	}
+	i = underlying(i)
+	value = map[string]T{}
+	err = nil
+
+	switch v := i.(type) {
+	case map[string]T:
+		value = v
+		return
+	case string:
+		m := map[string]interface{}{}
+		if e := json.Unmarshal([]uint8(v), &m); e != nil {
+			err = fmt.Errorf("unable to cast %#v of type %T to %T: %w", i, i, value, e)
+			return
+		}
+		i = m
+	}
+
+	m := map[string]interface{}{}
+	if e := reflectMap(i, mapOptions(nil), m); e != nil {
+		err = fmt.Errorf("unable to cast %#v of type %T to %T: %w", i, i, value, e)
+		return
+	}
+	for k, v := range m {
+		val, e := convert(v)
+		if e != nil {
+			value = map[string]T{}
+			err = fmt.Errorf("unable to cast %#v of type %T to %T: key %s: %w", i, i, value, k, e)
+			return
+		}
+		value[k] = val
+	}
+	return
+}
+
+// ToStringMapE casts an interface to a map[string]interface{} type.
+func ToStringMapE(i interface{}) (value map[string]interface{}, err error) {
+	return toStringMapE(i, func(i interface{}) (interface{}, error) { return i, nil })
+}
+func ToStringMap(i interface{}) map[string]interface{} {
+	v, _ := ToStringMapE(i)
+	return v
+}
+
+// ToStringMapStringE casts an interface to a map[string]string type.
+func ToStringMapStringE(i interface{}) (value map[string]string, err error) {
+	return toStringMapE(i, func(i interface{}) (string, error) { return ToStringE(i) })
+}
+func ToStringMapString(i interface{}) map[string]string {
+	v, _ := ToStringMapStringE(i)
+	return v
+}
+
+// ToStringMapIntE casts an interface to a map[string]int type.
+func ToStringMapIntE(i interface{}) (value map[string]int, err error) {
+	return toStringMapE(i, func(i interface{}) (int, error) { return ToIntE(i) })
+}
+func ToStringMapInt(i interface{}) map[string]int {
+	v, _ := ToStringMapIntE(i)
+	return v
+}
+
+// ToStringMapInt64E casts an interface to a map[string]int64 type.
+func ToStringMapInt64E(i interface{}) (value map[string]int64, err error) {
+	return toStringMapE(i, func(i interface{}) (int64, error) { return ToInt64E(i) })
+}
+func ToStringMapInt64(i interface{}) map[string]int64 {
+	v, _ := ToStringMapInt64E(i)
+	return v
+}
+
+// ToStringMapFloat64E casts an interface to a map[string]float64 type.
+func ToStringMapFloat64E(i interface{}) (value map[string]float64, err error) {
+	return toStringMapE(i, func(i interface{}) (float64, error) { return ToFloat64E(i) })
+}
+func ToStringMapFloat64(i interface{}) map[string]float64 {
+	v, _ := ToStringMapFloat64E(i)
+	return v
+}
+
+// ToStringMapBoolE casts an interface to a map[string]bool type.
+func ToStringMapBoolE(i interface{}) (value map[string]bool, err error) {
+	return toStringMapE(i, func(i interface{}) (bool, error) { return ToBoolE(i) })
+}
+func ToStringMapBool(i interface{}) map[string]bool {
+	v, _ := ToStringMapBoolE(i)
+	return v
+}
+
+// ToStringMapStringSliceE casts an interface to a map[string][]string type.
+func ToStringMapStringSliceE(i interface{}) (value map[string][]string, err error) {
+	return toStringMapE(i, func(i interface{}) ([]string, error) { return ToStringSliceE(i) })
+}
+func ToStringMapStringSlice(i interface{}) map[string][]string {
+	v, _ := ToStringMapStringSliceE(i)
+	return v
+}
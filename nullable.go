@@ -0,0 +1,294 @@
+package value
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// isNil reports whether i is nil, nil pointer, null sql.Null* value or Value holding one of them
+func isNil(i interface{}) bool {
+	if v, ok := i.(Value); ok {
+		i = v.value
+	}
+	i = indirect(underlying(i))
+	if i == nil {
+		return true
+	}
+	v := reflect.ValueOf(i)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+// toPtrE returns nil if i is nil, otherwise pointer to converted value
+func toPtrE[T any](i interface{}, convert func(interface{}) (T, error)) (*T, error) {
+	if isNil(i) {
+		return nil, nil
+	}
+	v, err := convert(i)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// convertAs casts an interface to T using conversion function for T
+func convertAs[T any](i interface{}) (value T, err error) {
+	var v interface{}
+	switch any(value).(type) {
+	case string:
+		v, err = ToStringE(i)
+	case bool:
+		v, err = ToBoolE(i)
+	case float64:
+		v, err = ToFloat64E(i)
+	case float32:
+		v, err = ToFloat32E(i)
+	case complex128:
+		v, err = ToComplex128E(i)
+	case complex64:
+		v, err = ToComplex64E(i)
+	case int64:
+		v, err = ToInt64E(i)
+	case int32:
+		v, err = ToInt32E(i)
+	case int16:
+		v, err = ToInt16E(i)
+	case int8:
+		v, err = ToInt8E(i)
+	case int:
+		v, err = ToIntE(i)
+	case uint64:
+		v, err = ToUint64E(i)
+	case uint32:
+		v, err = ToUint32E(i)
+	case uint16:
+		v, err = ToUint16E(i)
+	case uint8:
+		v, err = ToUint8E(i)
+	case uint:
+		v, err = ToUintE(i)
+	case time.Time:
+		v, err = ToTimeE(i)
+	case time.Duration:
+		v, err = ToDurationE(i)
+	case []string:
+		v, err = ToStringSliceE(i)
+	case []interface{}:
+		v, err = ToSliceE(i)
+	case []int:
+		v, err = ToIntSliceE(i)
+	case []bool:
+		v, err = ToBoolSliceE(i)
+	case []time.Time:
+		v, err = ToTimeSliceE(i)
+	case []Value:
+		v, err = ToValueSliceE(i)
+	case map[string]interface{}:
+		v, err = ToMapE(i)
+	case []map[string]interface{}:
+		v, err = ToMapSliceE(i)
+	case map[string]Value:
+		v, err = ToValueMapE(i)
+	case Value:
+		if val, ok := i.(Value); ok {
+			v = val
+		} else {
+			v = New(i)
+		}
+	default:
+		t, ok := i.(T)
+		if !ok {
+			err = fmt.Errorf("unable to cast %#v of type %T to %T", i, i, value)
+		}
+		return t, err
+	}
+	if err != nil {
+		return
+	}
+	value = v.(T)
+	return
+}
+
+// Nullable holds value of type T, V is valid when Valid is true.
+// Nullable implements sql.Scanner and driver.Valuer and is accepted as input by all conversions.
+type Nullable[T any] struct {
+	V     T
+	Valid bool
+}
+
+// Scan implements the sql.Scanner interface
+func (n *Nullable[T]) Scan(src interface{}) error {
+	n.V, n.Valid = *new(T), false
+	if isNil(src) {
+		return nil
+	}
+	v, err := convertAs[T](src)
+	if err != nil {
+		return err
+	}
+	n.V, n.Valid = v, true
+	return nil
+}
+
+// Value implements the driver.Valuer interface
+func (n Nullable[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(n.V)
+}
+
+// Ptr returns pointer to V or nil if not valid
+func (n Nullable[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+	v := n.V
+	return &v
+}
+
+// ToNullableE casts an interface to a Nullable[T] type, nil values are not valid.
+func ToNullableE[T any](i interface{}) (value Nullable[T], err error) {
+	err = value.Scan(i)
+	return
+}
+func ToNullable[T any](i interface{}) Nullable[T] {
+	v, _ := ToNullableE[T](i)
+	return v
+}
+
+// ToStringPtrE casts an interface to a *string type, nil when i is nil.
+func ToStringPtrE(i interface{}) (*string, error) {
+	return toPtrE(i, func(i interface{}) (string, error) { return ToStringE(i) })
+}
+func ToStringPtr(i interface{}) *string {
+	v, _ := ToStringPtrE(i)
+	return v
+}
+
+// ToBoolPtrE casts an interface to a *bool type, nil when i is nil.
+func ToBoolPtrE(i interface{}) (*bool, error) {
+	return toPtrE(i, func(i interface{}) (bool, error) { return ToBoolE(i) })
+}
+func ToBoolPtr(i interface{}) *bool {
+	v, _ := ToBoolPtrE(i)
+	return v
+}
+
+// ToFloat64PtrE casts an interface to a *float64 type, nil when i is nil.
+func ToFloat64PtrE(i interface{}) (*float64, error) {
+	return toPtrE(i, func(i interface{}) (float64, error) { return ToFloat64E(i) })
+}
+func ToFloat64Ptr(i interface{}) *float64 {
+	v, _ := ToFloat64PtrE(i)
+	return v
+}
+
+// ToFloat32PtrE casts an interface to a *float32 type, nil when i is nil.
+func ToFloat32PtrE(i interface{}) (*float32, error) {
+	return toPtrE(i, func(i interface{}) (float32, error) { return ToFloat32E(i) })
+}
+func ToFloat32Ptr(i interface{}) *float32 {
+	v, _ := ToFloat32PtrE(i)
+	return v
+}
+
+// ToInt64PtrE casts an interface to a *int64 type, nil when i is nil.
+func ToInt64PtrE(i interface{}) (*int64, error) {
+	return toPtrE(i, func(i interface{}) (int64, error) { return ToInt64E(i) })
+}
+func ToInt64Ptr(i interface{}) *int64 {
+	v, _ := ToInt64PtrE(i)
+	return v
+}
+
+// ToInt32PtrE casts an interface to a *int32 type, nil when i is nil.
+func ToInt32PtrE(i interface{}) (*int32, error) {
+	return toPtrE(i, func(i interface{}) (int32, error) { return ToInt32E(i) })
+}
+func ToInt32Ptr(i interface{}) *int32 {
+	v, _ := ToInt32PtrE(i)
+	return v
+}
+
+// ToInt16PtrE casts an interface to a *int16 type, nil when i is nil.
+func ToInt16PtrE(i interface{}) (*int16, error) {
+	return toPtrE(i, func(i interface{}) (int16, error) { return ToInt16E(i) })
+}
+func ToInt16Ptr(i interface{}) *int16 {
+	v, _ := ToInt16PtrE(i)
+	return v
+}
+
+// ToInt8PtrE casts an interface to a *int8 type, nil when i is nil.
+func ToInt8PtrE(i interface{}) (*int8, error) {
+	return toPtrE(i, func(i interface{}) (int8, error) { return ToInt8E(i) })
+}
+func ToInt8Ptr(i interface{}) *int8 {
+	v, _ := ToInt8PtrE(i)
+	return v
+}
+
+// ToIntPtrE casts an interface to a *int type, nil when i is nil.
+func ToIntPtrE(i interface{}) (*int, error) {
+	return toPtrE(i, func(i interface{}) (int, error) { return ToIntE(i) })
+}
+func ToIntPtr(i interface{}) *int {
+	v, _ := ToIntPtrE(i)
+	return v
+}
+
+// ToUint64PtrE casts an interface to a *uint64 type, nil when i is nil.
+func ToUint64PtrE(i interface{}) (*uint64, error) {
+	return toPtrE(i, func(i interface{}) (uint64, error) { return ToUint64E(i) })
+}
+func ToUint64Ptr(i interface{}) *uint64 {
+	v, _ := ToUint64PtrE(i)
+	return v
+}
+
+// ToUint32PtrE casts an interface to a *uint32 type, nil when i is nil.
+func ToUint32PtrE(i interface{}) (*uint32, error) {
+	return toPtrE(i, func(i interface{}) (uint32, error) { return ToUint32E(i) })
+}
+func ToUint32Ptr(i interface{}) *uint32 {
+	v, _ := ToUint32PtrE(i)
+	return v
+}
+
+// ToUint16PtrE casts an interface to a *uint16 type, nil when i is nil.
+func ToUint16PtrE(i interface{}) (*uint16, error) {
+	return toPtrE(i, func(i interface{}) (uint16, error) { return ToUint16E(i) })
+}
+func ToUint16Ptr(i interface{}) *uint16 {
+	v, _ := ToUint16PtrE(i)
+	return v
+}
+
+// ToUint8PtrE casts an interface to a *uint8 type, nil when i is nil.
+func ToUint8PtrE(i interface{}) (*uint8, error) {
+	return toPtrE(i, func(i interface{}) (uint8, error) { return ToUint8E(i) })
+}
+func ToUint8Ptr(i interface{}) *uint8 {
+	v, _ := ToUint8PtrE(i)
+	return v
+}
+
+// ToUintPtrE casts an interface to a *uint type, nil when i is nil.
+func ToUintPtrE(i interface{}) (*uint, error) {
+	return toPtrE(i, func(i interface{}) (uint, error) { return ToUintE(i) })
+}
+func ToUintPtr(i interface{}) *uint {
+	v, _ := ToUintPtrE(i)
+	return v
+}
+
+// ToTimePtrE casts an interface to a *time.Time type, nil when i is nil.
+func ToTimePtrE(i interface{}) (*time.Time, error) {
+	return toPtrE(i, func(i interface{}) (time.Time, error) { return ToTimeE(i) })
+}
+func ToTimePtr(i interface{}) *time.Time {
+	v, _ := ToTimePtrE(i)
+	return v
+}
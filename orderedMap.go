@@ -0,0 +1,206 @@
+package value
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// OrderedMap is a map[string]interface{} which keeps keys in insertion order.
+// It is accepted everywhere map[string]interface{} is, JSON objects decoded by Value and ToOrderedMapE
+// are OrderedMaps in document order and are encoded back in the same order.
+// Keys are changed only through Set and Delete, Map and ToMap return copies.
+type OrderedMap struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+// NewOrderedMap returns empty OrderedMap
+func NewOrderedMap() *OrderedMap {
+	return &OrderedMap{values: map[string]interface{}{}}
+}
+
+// Set sets value of key, new keys are appended to the end
+func (m *OrderedMap) Set(key string, value interface{}) {
+	if m.values == nil {
+		m.values = map[string]interface{}{}
+	}
+	if _, ok := m.values[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+// Get returns value of key
+func (m *OrderedMap) Get(key string) (interface{}, bool) {
+	v, ok := m.values[key]
+	return v, ok
+}
+
+// Delete removes key
+func (m *OrderedMap) Delete(key string) {
+	if _, ok := m.values[key]; !ok {
+		return
+	}
+	delete(m.values, key)
+	for i, k := range m.keys {
+		if k == key {
+			m.keys = append(m.keys[:i:i], m.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+// Len returns number of keys
+func (m *OrderedMap) Len() int {
+	return len(m.keys)
+}
+
+// Keys returns keys in insertion order
+func (m *OrderedMap) Keys() []string {
+	return append([]string{}, m.keys...)
+}
+
+// Map returns a copy of values as map[string]interface{}
+func (m *OrderedMap) Map() map[string]interface{} {
+	values := make(map[string]interface{}, len(m.values))
+	for k, v := range m.values {
+		values[k] = v
+	}
+	return values
+}
+
+// Entries returns key, value pairs in insertion order
+func (m *OrderedMap) Entries() []KeyValue {
+	entries := make([]KeyValue, len(m.keys))
+	for i, k := range m.keys {
+		entries[i] = KeyValue{Key: k, Value: New(m.values[k])}
+	}
+	return entries
+}
+
+// MarshalJSON implements the json.Marshaler interface, keys are written in insertion order
+func (m *OrderedMap) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, k := range m.keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		key, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(key)
+		b.WriteByte(':')
+		val, err := New(m.values[k]).MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		b.Write(val)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keys are kept in document order.
+// Nested objects are decoded as *OrderedMap, numbers as json.Number.
+func (m *OrderedMap) UnmarshalJSON(data []byte) error {
+	v, err := decodeOrderedJSON(data)
+	if err != nil {
+		return err
+	}
+	om, ok := v.(*OrderedMap)
+	if !ok {
+		return fmt.Errorf("unable to unmarshal %s into OrderedMap", data)
+	}
+	*m = *om
+	return nil
+}
+
+// decodeOrderedJSON decodes JSON with objects as *OrderedMap and numbers as json.Number
+func decodeOrderedJSON(data []byte) (interface{}, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	return decodeOrdered(d)
+}
+
+func decodeOrdered(d *json.Decoder) (interface{}, error) {
+	t, err := d.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t {
+	case json.Delim('{'):
+		m := NewOrderedMap()
+		for d.More() {
+			k, err := d.Token()
+			if err != nil {
+				return nil, err
+			}
+			v, err := decodeOrdered(d)
+			if err != nil {
+				return nil, err
+			}
+			m.Set(k.(string), v)
+		}
+		if _, err := d.Token(); err != nil {
+			return nil, err
+		}
+		return m, nil
+	case json.Delim('['):
+		s := []interface{}{}
+		for d.More() {
+			v, err := decodeOrdered(d)
+			if err != nil {
+				return nil, err
+			}
+			s = append(s, v)
+		}
+		if _, err := d.Token(); err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+	return t, nil
+}
+
+// ToOrderedMapE casts an interface to a *OrderedMap type.
+// Strings are decoded as JSON in document order, other maps are ordered by SortKeys.
+func ToOrderedMapE(i interface{}) (value *OrderedMap, err error) {
+	if v, ok := i.(Value); ok {
+		i = v.value
+	}
+	if v, ok := i.([]uint8); ok {
+		i = string(v)
+	}
+	i = underlying(i)
+	value = NewOrderedMap()
+	err = nil
+
+	switch v := i.(type) {
+	case *OrderedMap:
+		value = v
+	case string:
+		m := NewOrderedMap()
+		if e := m.UnmarshalJSON([]uint8(v)); e != nil {
+			err = fmt.Errorf("unable to cast %#v of type %T to *OrderedMap: %w", i, i, e)
+			return
+		}
+		value = m
+	default:
+		m, e := ToMapE(i)
+		if e != nil {
+			err = fmt.Errorf("unable to cast %#v of type %T to *OrderedMap: %w", i, i, e)
+			return
+		}
+		for _, k := range sortedKeys(m) {
+			value.Set(k, m[k])
+		}
+	}
+	return
+}
+func ToOrderedMap(i interface{}) *OrderedMap {
+	v, _ := ToOrderedMapE(i)
+	return v
+}
@@ -0,0 +1,137 @@
+package value
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestOrderedMapJSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		keys  []string
+		want  string
+	}{
+		{"empty", `{}`, []string{}, `{}`},
+		{"document order", `{"z":1,"a":2,"m":3}`, []string{"z", "a", "m"}, `{"z":1,"a":2,"m":3}`},
+		{"nested", `{"b":{"y":1,"x":2},"a":[{"d":1,"c":2}]}`, []string{"b", "a"}, `{"b":{"y":1,"x":2},"a":[{"d":1,"c":2}]}`},
+		{"numbers kept", `{"n":1.50,"big":12345678901234567890}`, []string{"n", "big"}, `{"n":1.50,"big":12345678901234567890}`},
+		{"scalars", `{"s":"x","t":true,"f":false,"null":null}`, []string{"s", "t", "f", "null"}, `{"s":"x","t":true,"f":false,"null":null}`},
+		{"white space", ` { "b" : 1 , "a" : [ 1 , 2 ] } `, []string{"b", "a"}, `{"b":1,"a":[1,2]}`},
+		{"escaped keys", `{"a\"b":1,"\u00e9":2}`, []string{`a"b`, "é"}, `{"a\"b":1,"é":2}`},
+		{"repeated key", `{"a":1,"b":2,"a":3}`, []string{"a", "b"}, `{"a":3,"b":2}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := NewOrderedMap()
+			if err := json.Unmarshal([]byte(test.input), m); err != nil {
+				t.Fatalf("Unmarshal(%s) error = %v", test.input, err)
+			}
+			if got := m.Keys(); !reflect.DeepEqual(got, test.keys) {
+				t.Errorf("Keys() = %q, want %q", got, test.keys)
+			}
+			data, err := json.Marshal(m)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			again := NewOrderedMap()
+			if err := json.Unmarshal(data, again); err != nil {
+				t.Fatalf("Unmarshal(%s) error = %v", data, err)
+			}
+			second, err := json.Marshal(again)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(second) != string(data) {
+				t.Errorf("Marshal() = %s, after round trip %s", data, second)
+			}
+			if string(data) != test.want {
+				t.Errorf("Marshal() = %s, want %s", data, test.want)
+			}
+		})
+	}
+}
+
+func TestOrderedMapNested(t *testing.T) {
+	m := ToOrderedMap(`{"b":{"y":1,"x":2},"a":[{"d":1,"c":2}]}`)
+	b, ok := m.Get("b")
+	if !ok {
+		t.Fatal(`Get("b") not found`)
+	}
+	if got := b.(*OrderedMap).Keys(); !reflect.DeepEqual(got, []string{"y", "x"}) {
+		t.Errorf(`Get("b").Keys() = %q, want ["y" "x"]`, got)
+	}
+	a, _ := m.Get("a")
+	if got := a.([]interface{})[0].(*OrderedMap).Keys(); !reflect.DeepEqual(got, []string{"d", "c"}) {
+		t.Errorf(`Get("a")[0].Keys() = %q, want ["d" "c"]`, got)
+	}
+	if got := New(m).MapGet("b.x").Int(); got != 2 {
+		t.Errorf(`MapGet("b.x") = %d, want 2`, got)
+	}
+}
+
+func TestOrderedMapEdits(t *testing.T) {
+	tests := []struct {
+		name string
+		edit func(m *OrderedMap)
+		want string
+	}{
+		{"set new key", func(m *OrderedMap) { m.Set("c", 3) }, `{"b":1,"a":2,"c":3}`},
+		{"set existing key", func(m *OrderedMap) { m.Set("b", 3) }, `{"b":3,"a":2}`},
+		{"delete", func(m *OrderedMap) { m.Delete("b") }, `{"a":2}`},
+		{"delete and set", func(m *OrderedMap) { m.Delete("b"); m.Set("b", 1) }, `{"a":2,"b":1}`},
+		{"delete missing", func(m *OrderedMap) { m.Delete("x") }, `{"b":1,"a":2}`},
+		{"map add", func(m *OrderedMap) { m.Map()["c"] = 3 }, `{"b":1,"a":2}`},
+		{"map delete", func(m *OrderedMap) { delete(m.Map(), "b") }, `{"b":1,"a":2}`},
+		{"ToMap add", func(m *OrderedMap) { ToMap(m)["c"] = 3 }, `{"b":1,"a":2}`},
+		{"ToMap copy", func(m *OrderedMap) { ToMap(m, MapOptions{Copy: true})["c"] = 3 }, `{"b":1,"a":2}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := ToOrderedMap(`{"b":1,"a":2}`)
+			test.edit(m)
+			data, err := json.Marshal(m)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(data) != test.want {
+				t.Errorf("Marshal() = %s, want %s", data, test.want)
+			}
+			if m.Len() != len(m.Keys()) || m.Len() != len(m.Entries()) {
+				t.Errorf("Len() = %d, len(Keys()) = %d, len(Entries()) = %d", m.Len(), len(m.Keys()), len(m.Entries()))
+			}
+		})
+	}
+}
+
+func TestToOrderedMapE(t *testing.T) {
+	tests := []struct {
+		name  string
+		input interface{}
+		keys  []string
+		err   bool
+	}{
+		{"json", `{"b":1,"a":2}`, []string{"b", "a"}, false},
+		{"bytes", []byte(`{"b":1,"a":2}`), []string{"b", "a"}, false},
+		{"map", map[string]int{"b": 1, "a": 2}, []string{"a", "b"}, false},
+		{"value", New(`{"b":1,"a":2}`), []string{"b", "a"}, false},
+		{"json array", `[1]`, []string{}, true},
+		{"invalid json", `{"a":`, []string{}, true},
+		{"number", 42, []string{}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m, err := ToOrderedMapE(test.input)
+			if (err != nil) != test.err {
+				t.Fatalf("ToOrderedMapE(%v) error = %v, want error %v", test.input, err, test.err)
+			}
+			if got := m.Keys(); !reflect.DeepEqual(got, test.keys) {
+				t.Errorf("ToOrderedMapE(%v).Keys() = %q, want %q", test.input, got, test.keys)
+			}
+		})
+	}
+}
@@ -0,0 +1,266 @@
+package value
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Dimension of a physical unit. Only units of the same dimension can be converted between.
+type Dimension string
+
+// Predefined dimensions
+const (
+	DimensionLength      Dimension = "length"
+	DimensionMass        Dimension = "mass"
+	DimensionTemperature Dimension = "temperature"
+	DimensionTime        Dimension = "time"
+	DimensionDataRate    Dimension = "data rate"
+)
+
+// ErrUnknownUnit is returned when a unit symbol is not registered.
+var ErrUnknownUnit = errors.New("unknown unit")
+
+// ErrDimensionMismatch is returned when converting between units of different dimensions.
+var ErrDimensionMismatch = errors.New("unit dimension mismatch")
+
+// Unit describes how to convert a unit to the base unit of its dimension:
+// base = value*Factor + Offset
+type Unit struct {
+	Symbol    string
+	Dimension Dimension
+	Factor    float64
+	Offset    float64
+}
+
+// Quantity is a number with a unit
+type Quantity struct {
+	Value float64
+	Unit  string
+}
+
+// String formats quantity as number followed by unit symbol
+func (q Quantity) String() string {
+	return strconv.FormatFloat(q.Value, 'f', -1, 64) + q.Unit
+}
+
+// To converts quantity to target unit
+func (q Quantity) To(targetUnit string) (Quantity, error) {
+	from, ok := LookupUnit(q.Unit)
+	if !ok {
+		return Quantity{}, fmt.Errorf("%w: %q", ErrUnknownUnit, q.Unit)
+	}
+	to, ok := LookupUnit(targetUnit)
+	if !ok {
+		return Quantity{}, fmt.Errorf("%w: %q", ErrUnknownUnit, targetUnit)
+	}
+	if from.Dimension != to.Dimension {
+		return Quantity{}, fmt.Errorf("%w: unable to convert %s (%s) to %s (%s)", ErrDimensionMismatch, q.Unit, from.Dimension, targetUnit, to.Dimension)
+	}
+	base := q.Value*from.Factor + from.Offset
+	return Quantity{Value: (base - to.Offset) / to.Factor, Unit: targetUnit}, nil
+}
+
+var (
+	unitsMu sync.RWMutex
+	units   = map[string]Unit{}
+)
+
+// RegisterUnit adds unit to registry under its symbol and optional aliases.
+// Registering existing symbol replaces it.
+func RegisterUnit(u Unit, aliases ...string) error {
+	if u.Symbol == "" {
+		return errors.New("unit symbol is empty")
+	}
+	if u.Factor == 0 {
+		return fmt.Errorf("unit %q factor is zero", u.Symbol)
+	}
+	unitsMu.Lock()
+	defer unitsMu.Unlock()
+	units[u.Symbol] = u
+	for _, alias := range aliases {
+		units[alias] = u
+	}
+	return nil
+}
+
+// LookupUnit returns registered unit by symbol or alias
+func LookupUnit(symbol string) (Unit, bool) {
+	unitsMu.RLock()
+	defer unitsMu.RUnlock()
+	u, ok := units[symbol]
+	return u, ok
+}
+
+func init() {
+	for _, u := range []struct {
+		unit    Unit
+		aliases []string
+	}{
+		// length, base meter
+		{Unit{"m", DimensionLength, 1, 0}, []string{"meter", "meters", "metre", "metres"}},
+		{Unit{"km", DimensionLength, 1e3, 0}, []string{"kilometer", "kilometers"}},
+		{Unit{"cm", DimensionLength, 1e-2, 0}, []string{"centimeter", "centimeters"}},
+		{Unit{"mm", DimensionLength, 1e-3, 0}, []string{"millimeter", "millimeters"}},
+		{Unit{"um", DimensionLength, 1e-6, 0}, []string{"µm", "micrometer", "micrometers"}},
+		{Unit{"nm", DimensionLength, 1e-9, 0}, []string{"nanometer", "nanometers"}},
+		{Unit{"in", DimensionLength, 0.0254, 0}, []string{"inch", "inches"}},
+		{Unit{"ft", DimensionLength, 0.3048, 0}, []string{"foot", "feet"}},
+		{Unit{"yd", DimensionLength, 0.9144, 0}, []string{"yard", "yards"}},
+		{Unit{"mi", DimensionLength, 1609.344, 0}, []string{"mile", "miles"}},
+		{Unit{"nmi", DimensionLength, 1852, 0}, []string{"nautical mile", "nautical miles"}},
+
+		// mass, base kilogram
+		{Unit{"kg", DimensionMass, 1, 0}, []string{"kilogram", "kilograms"}},
+		{Unit{"g", DimensionMass, 1e-3, 0}, []string{"gram", "grams"}},
+		{Unit{"mg", DimensionMass, 1e-6, 0}, []string{"milligram", "milligrams"}},
+		{Unit{"ug", DimensionMass, 1e-9, 0}, []string{"µg", "microgram", "micrograms"}},
+		{Unit{"t", DimensionMass, 1e3, 0}, []string{"tonne", "tonnes"}},
+		{Unit{"lb", DimensionMass, 0.45359237, 0}, []string{"lbs", "pound", "pounds"}},
+		{Unit{"oz", DimensionMass, 0.028349523125, 0}, []string{"ounce", "ounces"}},
+
+		// temperature, base kelvin
+		{Unit{"K", DimensionTemperature, 1, 0}, []string{"kelvin"}},
+		{Unit{"°C", DimensionTemperature, 1, 273.15}, []string{"C", "degC", "celsius"}},
+		{Unit{"°F", DimensionTemperature, 5.0 / 9.0, 459.67 * 5.0 / 9.0}, []string{"F", "degF", "fahrenheit"}},
+
+		// time, base second
+		{Unit{"s", DimensionTime, 1, 0}, []string{"sec", "second", "seconds"}},
+		{Unit{"ns", DimensionTime, 1e-9, 0}, []string{"nanosecond", "nanoseconds"}},
+		{Unit{"us", DimensionTime, 1e-6, 0}, []string{"µs", "microsecond", "microseconds"}},
+		{Unit{"ms", DimensionTime, 1e-3, 0}, []string{"millisecond", "milliseconds"}},
+		{Unit{"min", DimensionTime, 60, 0}, []string{"minute", "minutes"}},
+		{Unit{"h", DimensionTime, 3600, 0}, []string{"hr", "hour", "hours"}},
+		{Unit{"d", DimensionTime, 86400, 0}, []string{"day", "days"}},
+
+		// data rate, base bit per second
+		{Unit{"bps", DimensionDataRate, 1, 0}, []string{"bit/s"}},
+		{Unit{"kbps", DimensionDataRate, 1e3, 0}, []string{"kbit/s", "Kbps"}},
+		{Unit{"Mbps", DimensionDataRate, 1e6, 0}, []string{"Mbit/s"}},
+		{Unit{"Gbps", DimensionDataRate, 1e9, 0}, []string{"Gbit/s"}},
+		{Unit{"Tbps", DimensionDataRate, 1e12, 0}, []string{"Tbit/s"}},
+		{Unit{"B/s", DimensionDataRate, 8, 0}, []string{"Bps"}},
+		{Unit{"kB/s", DimensionDataRate, 8e3, 0}, []string{"KB/s", "kBps"}},
+		{Unit{"MB/s", DimensionDataRate, 8e6, 0}, []string{"MBps"}},
+		{Unit{"GB/s", DimensionDataRate, 8e9, 0}, []string{"GBps"}},
+		{Unit{"KiB/s", DimensionDataRate, 8 * 1024, 0}, nil},
+		{Unit{"MiB/s", DimensionDataRate, 8 * 1024 * 1024, 0}, nil},
+		{Unit{"GiB/s", DimensionDataRate, 8 * 1024 * 1024 * 1024, 0}, nil},
+	} {
+		RegisterUnit(u.unit, u.aliases...)
+	}
+}
+
+// ParseQuantity parses number followed by optional whitespace and unit symbol, for example "5km", "12.5 kg", "72°F".
+func ParseQuantity(s string) (Quantity, error) {
+	s = strings.TrimSpace(s)
+	n := numberPrefixLen(s)
+	if n == 0 {
+		return Quantity{}, fmt.Errorf("unable to parse quantity: %s", s)
+	}
+	f, err := strconv.ParseFloat(s[:n], 64)
+	if err != nil {
+		return Quantity{}, fmt.Errorf("unable to parse quantity: %s", s)
+	}
+	unit := strings.TrimSpace(s[n:])
+	if unit != "" {
+		if _, ok := LookupUnit(unit); !ok {
+			return Quantity{}, fmt.Errorf("%w: %q", ErrUnknownUnit, unit)
+		}
+	}
+	return Quantity{Value: f, Unit: unit}, nil
+}
+
+// numberPrefixLen returns length of decimal number at the start of s
+func numberPrefixLen(s string) int {
+	isDigit := func(i int) bool { return i < len(s) && s[i] >= '0' && s[i] <= '9' }
+	i := 0
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+	digits := 0
+	for ; isDigit(i); i++ {
+		digits++
+	}
+	if i < len(s) && s[i] == '.' {
+		i++
+		for ; isDigit(i); i++ {
+			digits++
+		}
+	}
+	if digits == 0 {
+		return 0
+	}
+	// exponent only when followed by digits, so "5e" is not eaten from unit
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			j++
+		}
+		if isDigit(j) {
+			for i = j; isDigit(i); i++ {
+			}
+		}
+	}
+	return i
+}
+
+// ToQuantityE casts an interface to a Quantity in target unit.
+// Strings are parsed with ParseQuantity, numbers without unit are treated as already in target unit,
+// time.Duration is treated as time quantity.
+func ToQuantityE(i interface{}, targetUnit string) (value Quantity, err error) {
+	if v, ok := i.([]uint8); ok {
+		i = string(v)
+	}
+	if v, ok := i.(Value); ok {
+		i = v.value
+	}
+	i = underlying(i)
+	i = indirect(i)
+	value = Quantity{Unit: targetUnit}
+	err = nil
+
+	if _, ok := LookupUnit(targetUnit); !ok {
+		err = fmt.Errorf("%w: %q", ErrUnknownUnit, targetUnit)
+		return
+	}
+
+	var q Quantity
+	switch v := i.(type) {
+	case Quantity:
+		q = v
+	case time.Duration:
+		q = Quantity{Value: float64(v), Unit: "ns"}
+	case string:
+		q, err = ParseQuantity(v)
+		if err != nil {
+			return
+		}
+	case nil:
+		return
+	default:
+		f, e := ToFloat64E(v)
+		if e != nil {
+			err = fmt.Errorf("unable to cast %#v of type %T to Quantity", i, i)
+			return
+		}
+		q = Quantity{Value: f}
+	}
+
+	if q.Unit == "" {
+		value.Value = q.Value
+		return
+	}
+	value, err = q.To(targetUnit)
+	if err != nil {
+		value = Quantity{Unit: targetUnit}
+	}
+	return
+}
+func ToQuantity(i interface{}, targetUnit string) Quantity {
+	v, _ := ToQuantityE(i, targetUnit)
+	return v
+}
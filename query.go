@@ -0,0 +1,292 @@
+package value
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// QueryStyle selects how nested keys are written by ToURLValues
+type QueryStyle int
+
+const (
+	// QueryBrackets writes nested keys as a[b][0]=x
+	QueryBrackets QueryStyle = iota
+	// QueryDots writes nested keys as a.b.0=x
+	QueryDots
+)
+
+// ToQueryMapE casts url.Values, map[string][]string or raw query string to a nested map[string]interface{} type.
+// Keys are nested by brackets a[b][0]=x and dots a.b=x, a[]=x appends to slice.
+// Repeated keys become []interface{}, maps with only index keys become []interface{} ordered by index,
+// other values are strings.
+func ToQueryMapE(i interface{}) (value map[string]interface{}, err error) {
+	if v, ok := i.(Value); ok {
+		i = v.value
+	}
+	if v, ok := i.([]uint8); ok {
+		i = string(v)
+	}
+	i = indirect(i)
+	value = map[string]interface{}{}
+	err = nil
+
+	switch v := i.(type) {
+	case url.Values:
+		err = setQueryValues(value, v)
+	case map[string][]string:
+		err = setQueryValues(value, v)
+	case string:
+		for _, pair := range strings.Split(strings.TrimPrefix(v, "?"), "&") {
+			if pair == "" {
+				continue
+			}
+			k, val, _ := strings.Cut(pair, "=")
+			key, e := url.QueryUnescape(k)
+			if e != nil {
+				err = fmt.Errorf("unable to cast %#v of type %T to map[string]interface{}: %w", i, i, e)
+				break
+			}
+			val, e = url.QueryUnescape(val)
+			if e != nil {
+				err = fmt.Errorf("unable to cast %#v of type %T to map[string]interface{}: %w", i, i, e)
+				break
+			}
+			if err = setQueryPath(value, queryPath(key), val, false); err != nil {
+				break
+			}
+		}
+	default:
+		err = fmt.Errorf("unable to cast %#v of type %T to map[string]interface{}", i, i)
+		return
+	}
+	if err != nil {
+		value = map[string]interface{}{}
+		return
+	}
+	for k, v := range value {
+		value[k] = indexedToSlices(v)
+	}
+	return
+}
+func ToQueryMap(i interface{}) map[string]interface{} {
+	v, _ := ToQueryMapE(i)
+	return v
+}
+
+func setQueryValues(m map[string]interface{}, values map[string][]string) error {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		path := queryPath(k)
+		for _, val := range values[k] {
+			if err := setQueryPath(m, path, val, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// queryPath splits key a.b[c][0] into a, b, c, 0
+func queryPath(key string) []string {
+	head, rest := key, ""
+	if j := strings.IndexByte(key, '['); j > 0 {
+		head, rest = key[:j], key[j:]
+	}
+	path := strings.Split(head, ".")
+	for rest != "" {
+		if rest[0] != '[' {
+			return []string{key}
+		}
+		end := strings.IndexByte(rest, ']')
+		if end < 0 {
+			return []string{key}
+		}
+		path = append(path, rest[1:end])
+		rest = rest[end+1:]
+	}
+	return path
+}
+
+// setQueryPath sets val at path of m, repeated values become []interface{}, empty nested part appends
+func setQueryPath(m map[string]interface{}, path []string, val string, nested bool) error {
+	key := path[0]
+	if key == "" && nested {
+		key = strconv.Itoa(len(m))
+	}
+	if len(path) == 1 {
+		switch old := m[key].(type) {
+		case nil:
+			m[key] = val
+		case string:
+			m[key] = []interface{}{old, val}
+		case []interface{}:
+			m[key] = append(old, val)
+		default:
+			return fmt.Errorf("query key %q is both value and nested key", key)
+		}
+		return nil
+	}
+	child, ok := m[key].(map[string]interface{})
+	if !ok {
+		if _, exists := m[key]; exists {
+			return fmt.Errorf("query key %q is both value and nested key", key)
+		}
+		child = map[string]interface{}{}
+		m[key] = child
+	}
+	return setQueryPath(child, path[1:], val, true)
+}
+
+// indexedToSlices converts nested maps with only index keys to slices ordered by index
+func indexedToSlices(i interface{}) interface{} {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return i
+	}
+	indexed := len(m) > 0
+	for k, v := range m {
+		m[k] = indexedToSlices(v)
+		if n, err := strconv.Atoi(k); err != nil || n < 0 || strconv.Itoa(n) != k {
+			indexed = false
+		}
+	}
+	if !indexed {
+		return m
+	}
+	indexes := make([]int, 0, len(m))
+	for k := range m {
+		n, _ := strconv.Atoi(k)
+		indexes = append(indexes, n)
+	}
+	sort.Ints(indexes)
+	s := make([]interface{}, len(indexes))
+	for j, n := range indexes {
+		s[j] = m[strconv.Itoa(n)]
+	}
+	return s
+}
+
+// ToURLValuesE casts maps, structs and Value trees to a url.Values type with nested keys written by style.
+// Slices of scalars are written as repeated keys, other slices with index keys.
+func ToURLValuesE(i interface{}, style ...QueryStyle) (value url.Values, err error) {
+	if v, ok := i.(Value); ok {
+		i = v.value
+	}
+	if v, ok := i.(url.Values); ok {
+		return v, nil
+	}
+	value = url.Values{}
+	s := QueryBrackets
+	if len(style) > 0 {
+		s = style[0]
+	}
+	entries, e := ToEntriesE(i)
+	if e != nil {
+		err = fmt.Errorf("unable to cast %#v of type %T to url.Values: %w", i, i, e)
+		return
+	}
+	for _, entry := range entries {
+		if e := addQueryValues(value, entry.Key, entry.Value.value, s); e != nil {
+			value = url.Values{}
+			err = fmt.Errorf("unable to cast %#v of type %T to url.Values: %w", i, i, e)
+			return
+		}
+	}
+	return
+}
+func ToURLValues(i interface{}, style ...QueryStyle) url.Values {
+	v, _ := ToURLValuesE(i, style...)
+	return v
+}
+
+func addQueryValues(values url.Values, key string, i interface{}, style QueryStyle) error {
+	i = queryValue(i)
+	switch queryKind(i) {
+	case reflect.Map:
+		entries, err := ToEntriesE(i)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if err := addQueryValues(values, queryKey(key, e.Key, style), e.Value.value, style); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Slice:
+		v := reflect.ValueOf(i)
+		nested := false
+		for j := 0; j < v.Len(); j++ {
+			if queryKind(v.Index(j).Interface()) != reflect.Invalid {
+				nested = true
+			}
+		}
+		for j := 0; j < v.Len(); j++ {
+			k := key
+			if nested {
+				k = queryKey(key, strconv.Itoa(j), style)
+			}
+			if err := addQueryValues(values, k, v.Index(j).Interface(), style); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if i == nil {
+		values.Add(key, "")
+		return nil
+	}
+	s, err := ToStringE(i)
+	if err != nil {
+		return err
+	}
+	values.Add(key, s)
+	return nil
+}
+
+// queryKind returns reflect.Map for maps, reflect.Slice for slices and arrays except []byte, reflect.Invalid for scalars
+func queryKind(i interface{}) reflect.Kind {
+	i = queryValue(i)
+	if _, ok := i.(*OrderedMap); ok {
+		return reflect.Map
+	}
+	if i == nil {
+		return reflect.Invalid
+	}
+	switch t := reflect.TypeOf(i); t.Kind() {
+	case reflect.Map:
+		return reflect.Map
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() != reflect.Uint8 {
+			return reflect.Slice
+		}
+	}
+	return reflect.Invalid
+}
+
+// queryValue unwraps Value, driver.Valuer and pointers except *OrderedMap
+func queryValue(i interface{}) interface{} {
+	if v, ok := i.(Value); ok {
+		i = v.value
+	}
+	i = underlying(i)
+	if _, ok := i.(*OrderedMap); ok {
+		return i
+	}
+	return indirect(i)
+}
+
+func queryKey(parent, key string, style QueryStyle) string {
+	if style == QueryDots {
+		return parent + "." + key
+	}
+	return parent + "[" + key + "]"
+}
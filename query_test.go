@@ -0,0 +1,108 @@
+package value
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestToQueryMapE(t *testing.T) {
+	tests := []struct {
+		name  string
+		input interface{}
+		want  map[string]interface{}
+		err   bool
+	}{
+		{"flat", "a=1&b=x", map[string]interface{}{"a": "1", "b": "x"}, false},
+		{"leading question mark", "?a=1", map[string]interface{}{"a": "1"}, false},
+		{"escaped", "a%5Bb%5D=x+y&c=%26", map[string]interface{}{"a": map[string]interface{}{"b": "x y"}, "c": "&"}, false},
+		{"brackets", "user[name]=ann&user[age]=3", map[string]interface{}{"user": map[string]interface{}{"name": "ann", "age": "3"}}, false},
+		{"dots", "db.host=x&db.port=1", map[string]interface{}{"db": map[string]interface{}{"host": "x", "port": "1"}}, false},
+		{"repeated", "tag=a&tag=b&tag=c", map[string]interface{}{"tag": []interface{}{"a", "b", "c"}}, false},
+		{"append", "a[]=x&a[]=y", map[string]interface{}{"a": []interface{}{"x", "y"}}, false},
+		{"indexed", "a[1]=y&a[0]=x&a[10]=z", map[string]interface{}{"a": []interface{}{"x", "y", "z"}}, false},
+		{"nested slice", "a[0][b]=x&a[1][b]=y", map[string]interface{}{"a": []interface{}{map[string]interface{}{"b": "x"}, map[string]interface{}{"b": "y"}}}, false},
+		{"not index", "a[01]=x", map[string]interface{}{"a": map[string]interface{}{"01": "x"}}, false},
+		{"empty value", "a=&b", map[string]interface{}{"a": "", "b": ""}, false},
+		{"unclosed bracket", "a[b=x", map[string]interface{}{"a[b": "x"}, false},
+		{"url.Values", url.Values{"a[b]": {"1"}, "c": {"2", "3"}}, map[string]interface{}{"a": map[string]interface{}{"b": "1"}, "c": []interface{}{"2", "3"}}, false},
+		{"map of slices", map[string][]string{"a.b": {"1"}}, map[string]interface{}{"a": map[string]interface{}{"b": "1"}}, false},
+		{"bytes", []byte("a=1"), map[string]interface{}{"a": "1"}, false},
+		{"value and nested key", "a=1&a[b]=2", map[string]interface{}{}, true},
+		{"bad escape", "a=1&b=%zz", map[string]interface{}{}, true},
+		{"bad key escape", "a=1&%zz=1", map[string]interface{}{}, true},
+		{"unsupported", 42, map[string]interface{}{}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ToQueryMapE(test.input)
+			if (err != nil) != test.err {
+				t.Fatalf("ToQueryMapE(%v) error = %v, want error %v", test.input, err, test.err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ToQueryMapE(%v) = %v, want %v", test.input, got, test.want)
+			}
+		})
+	}
+}
+
+func TestToURLValuesE(t *testing.T) {
+	type user struct {
+		Name string   `json:"name"`
+		Tags []string `json:"tags"`
+	}
+	tests := []struct {
+		name  string
+		input interface{}
+		style []QueryStyle
+		want  url.Values
+		err   bool
+	}{
+		{"flat", map[string]interface{}{"a": 1, "b": "x", "c": true}, nil, url.Values{"a": {"1"}, "b": {"x"}, "c": {"true"}}, false},
+		{"nil value", map[string]interface{}{"a": nil}, nil, url.Values{"a": {""}}, false},
+		{"brackets", map[string]interface{}{"user": map[string]interface{}{"name": "ann"}}, nil, url.Values{"user[name]": {"ann"}}, false},
+		{"dots", map[string]interface{}{"user": map[string]interface{}{"name": "ann"}}, []QueryStyle{QueryDots}, url.Values{"user.name": {"ann"}}, false},
+		{"repeated", map[string]interface{}{"tag": []string{"a", "b"}}, nil, url.Values{"tag": {"a", "b"}}, false},
+		{"indexed", map[string]interface{}{"a": []interface{}{map[string]interface{}{"b": 1}, "x"}}, nil, url.Values{"a[0][b]": {"1"}, "a[1]": {"x"}}, false},
+		{"indexed dots", map[string]interface{}{"a": []interface{}{[]int{1}}}, []QueryStyle{QueryDots}, url.Values{"a.0": {"1"}}, false},
+		{"struct", user{Name: "ann", Tags: []string{"x"}}, nil, url.Values{"name": {"ann"}, "tags": {"x"}}, false},
+		{"value", New(map[string]Value{"a": New(1)}), nil, url.Values{"a": {"1"}}, false},
+		{"url.Values", url.Values{"a": {"1"}}, nil, url.Values{"a": {"1"}}, false},
+		{"unsupported", 42, nil, url.Values{}, true},
+		{"unsupported value", map[string]interface{}{"a": make(chan int)}, nil, url.Values{}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ToURLValuesE(test.input, test.style...)
+			if (err != nil) != test.err {
+				t.Fatalf("ToURLValuesE(%v) error = %v, want error %v", test.input, err, test.err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ToURLValuesE(%v) = %v, want %v", test.input, got, test.want)
+			}
+		})
+	}
+}
+
+func TestQueryRoundTrip(t *testing.T) {
+	inputs := []string{
+		"a=1&b=x",
+		"user%5Bname%5D=ann&user%5Btags%5D=a&user%5Btags%5D=b",
+		"a%5B0%5D%5Bb%5D=x&a%5B1%5D%5Bb%5D=y",
+	}
+	for _, input := range inputs {
+		m, err := ToQueryMapE(input)
+		if err != nil {
+			t.Fatalf("ToQueryMapE(%q) error = %v", input, err)
+		}
+		values, err := ToURLValuesE(m)
+		if err != nil {
+			t.Fatalf("ToURLValuesE(%v) error = %v", m, err)
+		}
+		if got := values.Encode(); got != input {
+			t.Errorf("ToURLValuesE(ToQueryMapE(%q)).Encode() = %q", input, got)
+		}
+	}
+}
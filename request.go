@@ -0,0 +1,161 @@
+package value
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// RequestOptions configure FromRequest
+type RequestOptions struct {
+	// MaxBodySize limits size of request body, DefaultMaxBodySize when zero
+	MaxBodySize int64
+	// PathValues are names of path wildcards read by http.Request.PathValue
+	PathValues []string
+}
+
+// DefaultMaxBodySize is used by FromRequest when RequestOptions.MaxBodySize is zero
+var DefaultMaxBodySize int64 = 10 << 20
+
+// ErrBodyTooLarge is returned by FromRequest when request body exceeds MaxBodySize
+var ErrBodyTooLarge = errors.New("request body too large")
+
+// ErrUnsupportedContentType is returned by FromRequest for bodies which are not JSON, urlencoded or multipart form
+var ErrUnsupportedContentType = errors.New("unsupported content type")
+
+// FromRequest collects query, body and path values of r into map.
+// Query and form keys are nested like ToQueryMap, JSON body must be an object.
+// Only fields of multipart/form-data are read, files are skipped.
+// Body values override query values, path values override both. Request body is consumed.
+func FromRequest(r *http.Request, options ...RequestOptions) (map[string]Value, error) {
+	o := RequestOptions{}
+	if len(options) > 0 {
+		o = options[0]
+	}
+	if o.MaxBodySize <= 0 {
+		o.MaxBodySize = DefaultMaxBodySize
+	}
+
+	m, err := ToQueryMapE(r.URL.RawQuery)
+	if err != nil {
+		return nil, err
+	}
+	body, err := requestBody(r, o.MaxBodySize)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range body {
+		m[k] = v
+	}
+	for _, name := range o.PathValues {
+		if v := r.PathValue(name); v != "" {
+			m[name] = v
+		}
+	}
+
+	value := make(map[string]Value, len(m))
+	for k, v := range m {
+		value[k] = New(v)
+	}
+	return value, nil
+}
+
+// requestBody decodes body of r by its content type
+func requestBody(r *http.Request, maxSize int64) (map[string]interface{}, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, nil
+	}
+	contentType := r.Header.Get("Content-Type")
+	if contentType == "" {
+		return nil, nil
+	}
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedContentType, err)
+	}
+	body := http.MaxBytesReader(nil, r.Body, maxSize)
+	defer body.Close()
+
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		data, err := readBody(body)
+		if err != nil || len(strings.TrimSpace(string(data))) == 0 {
+			return nil, err
+		}
+		v, err := decodeOrderedJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		om, ok := v.(*OrderedMap)
+		if !ok {
+			return nil, fmt.Errorf("unable to read JSON body %.20q: not an object", data)
+		}
+		return om.Map(), nil
+	case mediaType == "application/x-www-form-urlencoded":
+		data, err := readBody(body)
+		if err != nil {
+			return nil, err
+		}
+		return ToQueryMapE(string(data))
+	case mediaType == "multipart/form-data":
+		values, err := multipartFields(body, params["boundary"])
+		if err != nil {
+			return nil, err
+		}
+		return ToQueryMapE(values)
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedContentType, mediaType)
+}
+
+// readBody reads whole body, exceeded limit is returned as ErrBodyTooLarge
+func readBody(body io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, limitError(err)
+	}
+	return data, nil
+}
+
+// multipartFields reads form fields of multipart body, file parts are skipped
+func multipartFields(body io.Reader, boundary string) (url.Values, error) {
+	if boundary == "" {
+		return nil, fmt.Errorf("%w: multipart boundary is missing", ErrUnsupportedContentType)
+	}
+	reader := multipart.NewReader(body, boundary)
+	values := url.Values{}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, limitError(err)
+		}
+		name := part.FormName()
+		if name == "" || part.FileName() != "" {
+			part.Close()
+			continue
+		}
+		data, err := readBody(part)
+		part.Close()
+		if err != nil {
+			return nil, err
+		}
+		values.Add(name, string(data))
+	}
+	return values, nil
+}
+
+// limitError converts http.MaxBytesError to ErrBodyTooLarge
+func limitError(err error) error {
+	var maxErr *http.MaxBytesError
+	if errors.As(err, &maxErr) {
+		return fmt.Errorf("%w: limit is %d bytes", ErrBodyTooLarge, maxErr.Limit)
+	}
+	return err
+}
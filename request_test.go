@@ -0,0 +1,184 @@
+package value
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func multipartBody(t *testing.T) (io.Reader, string) {
+	t.Helper()
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	w.WriteField("title", "hello")
+	w.WriteField("tags", "a")
+	w.WriteField("tags", "b")
+	fw, err := w.CreateFormFile("file", "f.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fw.Write([]byte("content"))
+	w.Close()
+	return &buf, w.FormDataContentType()
+}
+
+func TestFromRequest(t *testing.T) {
+	form, formType := multipartBody(t)
+	tests := []struct {
+		name        string
+		target      string
+		body        io.Reader
+		contentType string
+		options     RequestOptions
+		want        map[string]string
+		wantSlices  map[string][]string
+		err         error
+	}{
+		{
+			name:   "query",
+			target: "/?page=2&f[tag]=a&f.id=1",
+			want:   map[string]string{"page": "2", "f.tag": "a", "f.id": "1"},
+		},
+		{
+			name:        "json body overrides query",
+			target:      "/?name=q&page=2",
+			body:        strings.NewReader(`{"name":"x","db":{"port":5432}}`),
+			contentType: "application/json; charset=utf-8",
+			want:        map[string]string{"name": "x", "page": "2", "db.port": "5432"},
+		},
+		{
+			name:        "json suffix",
+			target:      "/",
+			body:        strings.NewReader(`{"a":true}`),
+			contentType: "application/merge-patch+json",
+			want:        map[string]string{"a": "true"},
+		},
+		{
+			name:        "empty json body",
+			target:      "/?a=1",
+			body:        strings.NewReader("  "),
+			contentType: "application/json",
+			want:        map[string]string{"a": "1"},
+		},
+		{
+			name:        "urlencoded",
+			target:      "/",
+			body:        strings.NewReader("a[b]=1&a[c]=2&x=y"),
+			contentType: "application/x-www-form-urlencoded",
+			want:        map[string]string{"a.b": "1", "a.c": "2", "x": "y"},
+		},
+		{
+			name:        "multipart skips files",
+			target:      "/",
+			body:        form,
+			contentType: formType,
+			want:        map[string]string{"title": "hello"},
+			wantSlices:  map[string][]string{"tags": {"a", "b"}},
+		},
+		{
+			name:        "body too large",
+			target:      "/",
+			body:        strings.NewReader(`{"a":"` + strings.Repeat("x", 100) + `"}`),
+			contentType: "application/json",
+			options:     RequestOptions{MaxBodySize: 10},
+			err:         ErrBodyTooLarge,
+		},
+		{
+			name:        "json array",
+			target:      "/",
+			body:        strings.NewReader(`[1]`),
+			contentType: "application/json",
+			err:         errors.New("not an object"),
+		},
+		{
+			name:        "unsupported content type",
+			target:      "/",
+			body:        strings.NewReader("x"),
+			contentType: "text/plain",
+			err:         ErrUnsupportedContentType,
+		},
+		{
+			name:        "multipart without boundary",
+			target:      "/",
+			body:        strings.NewReader("x"),
+			contentType: "multipart/form-data",
+			err:         ErrUnsupportedContentType,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, test.target, test.body)
+			if test.contentType != "" {
+				r.Header.Set("Content-Type", test.contentType)
+			}
+			m, err := FromRequest(r, test.options)
+			if test.err != nil {
+				if err == nil || !errors.Is(err, test.err) && !strings.Contains(err.Error(), test.err.Error()) {
+					t.Fatalf("FromRequest() error = %v, want %v", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromRequest() error = %v", err)
+			}
+			for path, want := range test.want {
+				if got := New(m).MapGet(path).String(); got != want {
+					t.Errorf("FromRequest()[%q] = %q, want %q", path, got, want)
+				}
+			}
+			for path, want := range test.wantSlices {
+				if got := New(m).MapGet(path).StringSlice(); !reflect.DeepEqual(got, want) {
+					t.Errorf("FromRequest()[%q] = %q, want %q", path, got, want)
+				}
+			}
+			if v, ok := m["file"]; ok {
+				t.Errorf("FromRequest()[\"file\"] = %v, want no file fields", v)
+			}
+		})
+	}
+}
+
+func TestFromRequestPathValues(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/items/7?id=1", strings.NewReader(`{"id":2}`))
+	r.Header.Set("Content-Type", "application/json")
+	r.SetPathValue("id", "7")
+	m, err := FromRequest(r, RequestOptions{PathValues: []string{"id", "missing"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := m["id"].Int(); got != 7 {
+		t.Errorf("FromRequest()[\"id\"] = %d, want 7", got)
+	}
+	if _, ok := m["missing"]; ok {
+		t.Errorf("FromRequest() contains empty path value")
+	}
+}
+
+func TestFromRequestConvertTo(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/?name=ann&age=2&tags=a&tags=b&db[port]=5432", nil)
+	m, err := FromRequest(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var payload struct {
+		Name string   `json:"name"`
+		Age  int      `json:"age"`
+		Tags []string `json:"tags"`
+		DB   struct {
+			Port int `json:"port"`
+		} `json:"db"`
+	}
+	if err := ConvertTo(New(m), &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.Name != "ann" || payload.Age != 2 || len(payload.Tags) != 2 || payload.DB.Port != 5432 {
+		t.Errorf("ConvertTo() = %+v", payload)
+	}
+}
@@ -0,0 +1,162 @@
+package value
+
+import (
+	"errors"
+	"math"
+	"strconv"
+)
+
+// RoundingMode defines how fractional values are cast to integer types
+type RoundingMode int
+
+// Rounding modes
+const (
+	// RoundTruncate drops fractional part: 2.9 -> 2, -2.9 -> -2
+	RoundTruncate RoundingMode = iota
+	// RoundFloor rounds towards negative infinity: 2.9 -> 2, -2.1 -> -3
+	RoundFloor
+	// RoundCeil rounds towards positive infinity: 2.1 -> 3, -2.9 -> -2
+	RoundCeil
+	// RoundHalfEven rounds to nearest, ties to even: 2.5 -> 2, 3.5 -> 4
+	RoundHalfEven
+	// RoundHalfAway rounds to nearest, ties away from zero: 2.5 -> 3, -2.5 -> -3
+	RoundHalfAway
+	// RoundReject returns ErrFractionalNotAllowed for values with fractional part
+	RoundReject
+)
+
+// IntRounding is the rounding mode applied by every integer conversion
+// to float inputs and to decimal or scientific notation strings like "12.0" and "1e3".
+// Use Converter to apply other mode per call.
+var IntRounding = RoundTruncate
+
+// ErrFractionalNotAllowed is returned when IntRounding is RoundReject and value has fractional part.
+var ErrFractionalNotAllowed = errors.New("unable to cast fractional value")
+
+// Round rounds f to an integral value using mode
+func (mode RoundingMode) Round(f float64) (float64, error) {
+	switch mode {
+	case RoundFloor:
+		return math.Floor(f), nil
+	case RoundCeil:
+		return math.Ceil(f), nil
+	case RoundHalfEven:
+		return math.RoundToEven(f), nil
+	case RoundHalfAway:
+		return math.Round(f), nil
+	case RoundReject:
+		if f != math.Trunc(f) {
+			return 0, ErrFractionalNotAllowed
+		}
+		return f, nil
+	default:
+		return math.Trunc(f), nil
+	}
+}
+
+// floatToInt64 applies special float policies, rounds f with IntRounding of c and casts it to
+// a signed integer of bitSize bits (0 for int) returned as int64
+func (c Converter) floatToInt64(f float64, bitSize int) (int64, error) {
+	if bitSize == 0 {
+		bitSize = strconv.IntSize
+	}
+	f, err := c.specialFloatForInt(f)
+	if err != nil {
+		return 0, err
+	}
+	f, err = c.IntRounding.Round(f)
+	if err != nil {
+		return 0, err
+	}
+	if limit := math.Ldexp(1, bitSize-1); f < -limit || f >= limit {
+		return 0, strconv.ErrRange
+	}
+	return int64(f), nil
+}
+
+// floatToUint64 applies special float policies, rounds f with IntRounding of c and casts it to
+// an unsigned integer of bitSize bits (0 for uint) returned as uint64
+func (c Converter) floatToUint64(f float64, bitSize int) (uint64, error) {
+	if bitSize == 0 {
+		bitSize = strconv.IntSize
+	}
+	f, err := c.specialFloatForInt(f)
+	if err != nil {
+		return 0, err
+	}
+	f, err = c.IntRounding.Round(f)
+	if err != nil {
+		return 0, err
+	}
+	if f < 0 {
+		return 0, errNegativeNotAllowed
+	}
+	if f >= math.Ldexp(1, bitSize) {
+		return 0, strconv.ErrRange
+	}
+	return uint64(f), nil
+}
+
+// intToInt64 casts integer i to a signed integer of bitSize bits (0 for int) returned as int64,
+// values not fitting are rejected with strconv.ErrRange
+func intToInt64(i interface{}, bitSize int) (int64, error) {
+	if bitSize == 0 {
+		bitSize = strconv.IntSize
+	}
+	negative, magnitude := integerParts(i)
+	limit := uint64(1) << uint(bitSize-1)
+	if negative && magnitude > limit || !negative && magnitude >= limit {
+		return 0, strconv.ErrRange
+	}
+	if negative {
+		return -int64(magnitude), nil
+	}
+	return int64(magnitude), nil
+}
+
+// intToUint64 casts integer i to an unsigned integer of bitSize bits (0 for uint) returned as uint64,
+// negative values are rejected with errNegativeNotAllowed and values not fitting with strconv.ErrRange
+func intToUint64(i interface{}, bitSize int) (uint64, error) {
+	if bitSize == 0 {
+		bitSize = strconv.IntSize
+	}
+	negative, magnitude := integerParts(i)
+	if negative {
+		return 0, errNegativeNotAllowed
+	}
+	if bitSize < 64 && magnitude > 1<<uint(bitSize)-1 {
+		return 0, strconv.ErrRange
+	}
+	return magnitude, nil
+}
+
+// integerParts splits integer i into sign and magnitude
+func integerParts(i interface{}) (negative bool, magnitude uint64) {
+	var v int64
+	switch s := i.(type) {
+	case int:
+		v = int64(s)
+	case int64:
+		v = s
+	case int32:
+		v = int64(s)
+	case int16:
+		v = int64(s)
+	case int8:
+		v = int64(s)
+	case uint:
+		return false, uint64(s)
+	case uint64:
+		return false, s
+	case uint32:
+		return false, uint64(s)
+	case uint16:
+		return false, uint64(s)
+	case uint8:
+		return false, uint64(s)
+	}
+	if v < 0 {
+		return true, uint64(-(v + 1)) + 1
+	}
+	return false, uint64(v)
+}
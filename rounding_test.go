@@ -0,0 +1,50 @@
+package value
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestIntegerTargetRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		convert func(interface{}) (interface{}, error)
+		input   interface{}
+		want    interface{}
+		err     error
+	}{
+		{"int8 from int", func(i interface{}) (interface{}, error) { return ToInt8E(i) }, 127, int8(127), nil},
+		{"int8 from int min", func(i interface{}) (interface{}, error) { return ToInt8E(i) }, -128, int8(-128), nil},
+		{"int8 overflow", func(i interface{}) (interface{}, error) { return ToInt8E(i) }, 200, int8(0), strconv.ErrRange},
+		{"int8 underflow", func(i interface{}) (interface{}, error) { return ToInt8E(i) }, int16(-129), int8(0), strconv.ErrRange},
+		{"int8 from uint8", func(i interface{}) (interface{}, error) { return ToInt8E(i) }, uint8(200), int8(0), strconv.ErrRange},
+		{"int8 from float", func(i interface{}) (interface{}, error) { return ToInt8E(i) }, 200.0, int8(0), strconv.ErrRange},
+		{"int16 overflow", func(i interface{}) (interface{}, error) { return ToInt16E(i) }, int32(40000), int16(0), strconv.ErrRange},
+		{"int32 overflow", func(i interface{}) (interface{}, error) { return ToInt32E(i) }, int64(1 << 40), int32(0), strconv.ErrRange},
+		{"int32 from float", func(i interface{}) (interface{}, error) { return ToInt32E(i) }, float32(1 << 31), int32(0), strconv.ErrRange},
+		{"int64 min", func(i interface{}) (interface{}, error) { return ToInt64E(i) }, int64(math.MinInt64), int64(math.MinInt64), nil},
+		{"int64 from uint64", func(i interface{}) (interface{}, error) { return ToInt64E(i) }, uint64(math.MaxUint64), int64(0), strconv.ErrRange},
+		{"uint8 max", func(i interface{}) (interface{}, error) { return ToUint8E(i) }, 255, uint8(255), nil},
+		{"uint8 overflow", func(i interface{}) (interface{}, error) { return ToUint8E(i) }, 256, uint8(0), strconv.ErrRange},
+		{"uint8 negative", func(i interface{}) (interface{}, error) { return ToUint8E(i) }, int8(-1), uint8(0), errNegativeNotAllowed},
+		{"uint16 overflow", func(i interface{}) (interface{}, error) { return ToUint16E(i) }, uint32(70000), uint16(0), strconv.ErrRange},
+		{"uint32 overflow", func(i interface{}) (interface{}, error) { return ToUint32E(i) }, int64(1 << 40), uint32(0), strconv.ErrRange},
+		{"uint32 from float", func(i interface{}) (interface{}, error) { return ToUint32E(i) }, 4294967296.0, uint32(0), strconv.ErrRange},
+		{"uint64 max", func(i interface{}) (interface{}, error) { return ToUint64E(i) }, uint64(math.MaxUint64), uint64(math.MaxUint64), nil},
+		{"uint64 negative", func(i interface{}) (interface{}, error) { return ToUint64E(i) }, int64(math.MinInt64), uint64(0), errNegativeNotAllowed},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.convert(test.input)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("convert(%v) error = %v, want %v", test.input, err, test.err)
+			}
+			if got != test.want {
+				t.Errorf("convert(%v) = %v, want %v", test.input, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,318 @@
+package value
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// toSliceE casts an interface to a []T type converting each element with convert.
+// Common source slice types are converted without reflection,
+// strings and numbers are split by ToStringSliceWithE with o.
+func toSliceE[T any](i interface{}, convert func(interface{}) (T, error), o SplitOptions) (value []T, err error) {
+	if v, ok := i.(Value); ok {
+		i = v.value
+	}
+	if v, ok := i.([]T); ok {
+		return v, nil
+	}
+	if v, ok := i.([]uint8); ok {
+		i = string(v)
+	}
+	i = underlying(i)
+	value = []T{}
+	err = nil
+	if i == nil {
+		err = fmt.Errorf("unable to cast %#v of type %T to %T", i, i, value)
+		return
+	}
+
+	switch v := i.(type) {
+	case []T:
+		value = v
+	case []interface{}:
+		value, err = convertSlice(v, convert)
+	case []Value:
+		value, err = convertSlice(v, convert)
+	case []string:
+		value, err = convertSlice(v, convert)
+	case []int:
+		value, err = convertSlice(v, convert)
+	case []int64:
+		value, err = convertSlice(v, convert)
+	case []int32:
+		value, err = convertSlice(v, convert)
+	case []uint:
+		value, err = convertSlice(v, convert)
+	case []uint64:
+		value, err = convertSlice(v, convert)
+	case []uint32:
+		value, err = convertSlice(v, convert)
+	case []float64:
+		value, err = convertSlice(v, convert)
+	case []float32:
+		value, err = convertSlice(v, convert)
+	case []bool:
+		value, err = convertSlice(v, convert)
+	case string, float64, float32, int64, int32, int16, int8, int, uint64, uint32, uint16, uint8, uint:
+		strArr, e := ToStringSliceWithE(v, o)
+		if e != nil {
+			err = e
+			break
+		}
+		value, err = convertSlice(strArr, convert)
+	default:
+		kind := reflect.TypeOf(i).Kind()
+		if kind != reflect.Slice && kind != reflect.Array {
+			err = fmt.Errorf("unable to cast %#v of type %T to %T", i, i, value)
+			return
+		}
+		s := reflect.ValueOf(i)
+		a := make([]T, s.Len())
+		for j := 0; j < s.Len(); j++ {
+			val, e := convert(s.Index(j).Interface())
+			if e != nil {
+				err = e
+				break
+			}
+			a[j] = val
+		}
+		if err == nil {
+			value = a
+		}
+	}
+	if err != nil {
+		value = []T{}
+		err = fmt.Errorf("unable to cast %#v of type %T to %T: %w", i, i, value, err)
+	}
+	return
+}
+
+// convertSlice converts each element of s with convert
+func convertSlice[S any, T any](s []S, convert func(interface{}) (T, error)) ([]T, error) {
+	a := make([]T, len(s))
+	for j, val := range s {
+		v, err := convert(val)
+		if err != nil {
+			return nil, err
+		}
+		a[j] = v
+	}
+	return a, nil
+}
+
+// ToInt64SliceE casts an interface to a []int64 type.
+func ToInt64SliceE(i interface{}, seperator ...string) (value []int64, err error) {
+	return ToInt64SliceWithE(i, splitOptions(seperator))
+}
+func ToInt64Slice(i interface{}, seperator ...string) []int64 {
+	v, _ := ToInt64SliceE(i, seperator...)
+	return v
+}
+
+// ToInt64SliceWithE casts an interface to a []int64 type, strings are split by o.
+func ToInt64SliceWithE(i interface{}, o SplitOptions) (value []int64, err error) {
+	return toSliceE(i, func(i interface{}) (int64, error) { return ToInt64E(i) }, numberSplit(o))
+}
+func ToInt64SliceWith(i interface{}, o SplitOptions) []int64 {
+	v, _ := ToInt64SliceWithE(i, o)
+	return v
+}
+
+// ToInt32SliceE casts an interface to a []int32 type.
+func ToInt32SliceE(i interface{}, seperator ...string) (value []int32, err error) {
+	return ToInt32SliceWithE(i, splitOptions(seperator))
+}
+func ToInt32Slice(i interface{}, seperator ...string) []int32 {
+	v, _ := ToInt32SliceE(i, seperator...)
+	return v
+}
+
+// ToInt32SliceWithE casts an interface to a []int32 type, strings are split by o.
+func ToInt32SliceWithE(i interface{}, o SplitOptions) (value []int32, err error) {
+	return toSliceE(i, func(i interface{}) (int32, error) { return ToInt32E(i) }, numberSplit(o))
+}
+func ToInt32SliceWith(i interface{}, o SplitOptions) []int32 {
+	v, _ := ToInt32SliceWithE(i, o)
+	return v
+}
+
+// ToInt16SliceE casts an interface to a []int16 type.
+func ToInt16SliceE(i interface{}, seperator ...string) (value []int16, err error) {
+	return ToInt16SliceWithE(i, splitOptions(seperator))
+}
+func ToInt16Slice(i interface{}, seperator ...string) []int16 {
+	v, _ := ToInt16SliceE(i, seperator...)
+	return v
+}
+
+// ToInt16SliceWithE casts an interface to a []int16 type, strings are split by o.
+func ToInt16SliceWithE(i interface{}, o SplitOptions) (value []int16, err error) {
+	return toSliceE(i, func(i interface{}) (int16, error) { return ToInt16E(i) }, numberSplit(o))
+}
+func ToInt16SliceWith(i interface{}, o SplitOptions) []int16 {
+	v, _ := ToInt16SliceWithE(i, o)
+	return v
+}
+
+// ToInt8SliceE casts an interface to a []int8 type.
+func ToInt8SliceE(i interface{}, seperator ...string) (value []int8, err error) {
+	return ToInt8SliceWithE(i, splitOptions(seperator))
+}
+func ToInt8Slice(i interface{}, seperator ...string) []int8 {
+	v, _ := ToInt8SliceE(i, seperator...)
+	return v
+}
+
+// ToInt8SliceWithE casts an interface to a []int8 type, strings are split by o.
+func ToInt8SliceWithE(i interface{}, o SplitOptions) (value []int8, err error) {
+	return toSliceE(i, func(i interface{}) (int8, error) { return ToInt8E(i) }, numberSplit(o))
+}
+func ToInt8SliceWith(i interface{}, o SplitOptions) []int8 {
+	v, _ := ToInt8SliceWithE(i, o)
+	return v
+}
+
+// ToUintSliceE casts an interface to a []uint type.
+func ToUintSliceE(i interface{}, seperator ...string) (value []uint, err error) {
+	return ToUintSliceWithE(i, splitOptions(seperator))
+}
+func ToUintSlice(i interface{}, seperator ...string) []uint {
+	v, _ := ToUintSliceE(i, seperator...)
+	return v
+}
+
+// ToUintSliceWithE casts an interface to a []uint type, strings are split by o.
+func ToUintSliceWithE(i interface{}, o SplitOptions) (value []uint, err error) {
+	return toSliceE(i, func(i interface{}) (uint, error) { return ToUintE(i) }, numberSplit(o))
+}
+func ToUintSliceWith(i interface{}, o SplitOptions) []uint {
+	v, _ := ToUintSliceWithE(i, o)
+	return v
+}
+
+// ToUint64SliceE casts an interface to a []uint64 type.
+func ToUint64SliceE(i interface{}, seperator ...string) (value []uint64, err error) {
+	return ToUint64SliceWithE(i, splitOptions(seperator))
+}
+func ToUint64Slice(i interface{}, seperator ...string) []uint64 {
+	v, _ := ToUint64SliceE(i, seperator...)
+	return v
+}
+
+// ToUint64SliceWithE casts an interface to a []uint64 type, strings are split by o.
+func ToUint64SliceWithE(i interface{}, o SplitOptions) (value []uint64, err error) {
+	return toSliceE(i, func(i interface{}) (uint64, error) { return ToUint64E(i) }, numberSplit(o))
+}
+func ToUint64SliceWith(i interface{}, o SplitOptions) []uint64 {
+	v, _ := ToUint64SliceWithE(i, o)
+	return v
+}
+
+// ToUint32SliceE casts an interface to a []uint32 type.
+func ToUint32SliceE(i interface{}, seperator ...string) (value []uint32, err error) {
+	return ToUint32SliceWithE(i, splitOptions(seperator))
+}
+func ToUint32Slice(i interface{}, seperator ...string) []uint32 {
+	v, _ := ToUint32SliceE(i, seperator...)
+	return v
+}
+
+// ToUint32SliceWithE casts an interface to a []uint32 type, strings are split by o.
+func ToUint32SliceWithE(i interface{}, o SplitOptions) (value []uint32, err error) {
+	return toSliceE(i, func(i interface{}) (uint32, error) { return ToUint32E(i) }, numberSplit(o))
+}
+func ToUint32SliceWith(i interface{}, o SplitOptions) []uint32 {
+	v, _ := ToUint32SliceWithE(i, o)
+	return v
+}
+
+// ToUint16SliceE casts an interface to a []uint16 type.
+func ToUint16SliceE(i interface{}, seperator ...string) (value []uint16, err error) {
+	return ToUint16SliceWithE(i, splitOptions(seperator))
+}
+func ToUint16Slice(i interface{}, seperator ...string) []uint16 {
+	v, _ := ToUint16SliceE(i, seperator...)
+	return v
+}
+
+// ToUint16SliceWithE casts an interface to a []uint16 type, strings are split by o.
+func ToUint16SliceWithE(i interface{}, o SplitOptions) (value []uint16, err error) {
+	return toSliceE(i, func(i interface{}) (uint16, error) { return ToUint16E(i) }, numberSplit(o))
+}
+func ToUint16SliceWith(i interface{}, o SplitOptions) []uint16 {
+	v, _ := ToUint16SliceWithE(i, o)
+	return v
+}
+
+// ToUint8SliceE casts an interface to a []uint8 type.
+func ToUint8SliceE(i interface{}, seperator ...string) (value []uint8, err error) {
+	return ToUint8SliceWithE(i, splitOptions(seperator))
+}
+func ToUint8Slice(i interface{}, seperator ...string) []uint8 {
+	v, _ := ToUint8SliceE(i, seperator...)
+	return v
+}
+
+// ToUint8SliceWithE casts an interface to a []uint8 type, strings are split by o.
+func ToUint8SliceWithE(i interface{}, o SplitOptions) (value []uint8, err error) {
+	return toSliceE(i, func(i interface{}) (uint8, error) { return ToUint8E(i) }, numberSplit(o))
+}
+func ToUint8SliceWith(i interface{}, o SplitOptions) []uint8 {
+	v, _ := ToUint8SliceWithE(i, o)
+	return v
+}
+
+// ToFloat64SliceE casts an interface to a []float64 type.
+func ToFloat64SliceE(i interface{}, seperator ...string) (value []float64, err error) {
+	return ToFloat64SliceWithE(i, splitOptions(seperator))
+}
+func ToFloat64Slice(i interface{}, seperator ...string) []float64 {
+	v, _ := ToFloat64SliceE(i, seperator...)
+	return v
+}
+
+// ToFloat64SliceWithE casts an interface to a []float64 type, strings are split by o.
+func ToFloat64SliceWithE(i interface{}, o SplitOptions) (value []float64, err error) {
+	return toSliceE(i, func(i interface{}) (float64, error) { return ToFloat64E(i) }, numberSplit(o))
+}
+func ToFloat64SliceWith(i interface{}, o SplitOptions) []float64 {
+	v, _ := ToFloat64SliceWithE(i, o)
+	return v
+}
+
+// ToFloat32SliceE casts an interface to a []float32 type.
+func ToFloat32SliceE(i interface{}, seperator ...string) (value []float32, err error) {
+	return ToFloat32SliceWithE(i, splitOptions(seperator))
+}
+func ToFloat32Slice(i interface{}, seperator ...string) []float32 {
+	v, _ := ToFloat32SliceE(i, seperator...)
+	return v
+}
+
+// ToFloat32SliceWithE casts an interface to a []float32 type, strings are split by o.
+func ToFloat32SliceWithE(i interface{}, o SplitOptions) (value []float32, err error) {
+	return toSliceE(i, func(i interface{}) (float32, error) { return ToFloat32E(i) }, numberSplit(o))
+}
+func ToFloat32SliceWith(i interface{}, o SplitOptions) []float32 {
+	v, _ := ToFloat32SliceWithE(i, o)
+	return v
+}
+
+// ToDurationSliceE casts an interface to a []time.Duration type.
+func ToDurationSliceE(i interface{}, seperator ...string) (value []time.Duration, err error) {
+	return ToDurationSliceWithE(i, splitOptions(seperator))
+}
+func ToDurationSlice(i interface{}, seperator ...string) []time.Duration {
+	v, _ := ToDurationSliceE(i, seperator...)
+	return v
+}
+
+// ToDurationSliceWithE casts an interface to a []time.Duration type, strings are split by o.
+func ToDurationSliceWithE(i interface{}, o SplitOptions) (value []time.Duration, err error) {
+	return toSliceE(i, func(i interface{}) (time.Duration, error) { return ToDurationE(i) }, numberSplit(o))
+}
+func ToDurationSliceWith(i interface{}, o SplitOptions) []time.Duration {
+	v, _ := ToDurationSliceWithE(i, o)
+	return v
+}
@@ -0,0 +1,64 @@
+package value
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestToIntSliceE(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     interface{}
+		seperator []string
+		want      []int
+		err       bool
+	}{
+		{"comma", "1,2,3", nil, []int{1, 2, 3}, false},
+		{"semicolon", "1;2;3", nil, []int{1, 2, 3}, false},
+		{"pipe", "1|2|3", nil, []int{1, 2, 3}, false},
+		{"white space", "1 2\t3", nil, []int{1, 2, 3}, false},
+		{"seperator", "1;2;3", []string{";"}, []int{1, 2, 3}, false},
+		{"slice", []string{"1", "2"}, nil, []int{1, 2}, false},
+		{"invalid", "1,x", nil, []int{}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ToIntSliceE(test.input, test.seperator...)
+			if (err != nil) != test.err {
+				t.Fatalf("ToIntSliceE(%v) error = %v, want error %v", test.input, err, test.err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ToIntSliceE(%v) = %v, want %v", test.input, got, test.want)
+			}
+		})
+	}
+}
+
+func TestTypedNumberSlices(t *testing.T) {
+	tests := []struct {
+		name    string
+		convert func(interface{}) (interface{}, error)
+		input   interface{}
+		want    interface{}
+	}{
+		{"int64 signs", func(i interface{}) (interface{}, error) { return ToInt64SliceE(i) }, "1, -2 +3", []int64{1, -2, 3}},
+		{"uint8", func(i interface{}) (interface{}, error) { return ToUint8SliceE(i) }, "1,255", []uint8{1, 255}},
+		{"float64 decimals", func(i interface{}) (interface{}, error) { return ToFloat64SliceE(i) }, "1.5, -2", []float64{1.5, -2}},
+		{"float32 seperator", func(i interface{}) (interface{}, error) { return ToFloat32SliceE(i, ";") }, "1.5;2", []float32{1.5, 2}},
+		{"duration units", func(i interface{}) (interface{}, error) { return ToDurationSliceE(i) }, "1.5s,2m", []time.Duration{1500 * time.Millisecond, 2 * time.Minute}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.convert(test.input)
+			if err != nil {
+				t.Fatalf("convert(%v) error = %v", test.input, err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("convert(%v) = %v, want %v", test.input, got, test.want)
+			}
+		})
+	}
+}
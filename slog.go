@@ -0,0 +1,183 @@
+package value
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+	"time"
+)
+
+// LogValue implements the slog.LogValuer interface.
+// Maps are logged as groups, scalars as typed attributes.
+func (v Value) LogValue() slog.Value {
+	s, err := ToSlogValueE(v.value)
+	if err != nil {
+		return slog.AnyValue(v.value)
+	}
+	return s
+}
+
+// ToSlogValueE casts an interface to a slog.Value type.
+// Maps become groups with keys ordered by SortKeys (OrderedMap keeps insertion order), slices become []interface{} of plain values.
+// Recursive references are logged as string "<cycle T>".
+func ToSlogValueE(i interface{}) (value slog.Value, err error) {
+	return toSlogValueE(i, map[dumpRef]bool{})
+}
+
+// toSlogValueE converts i to slog.Value, visiting holds maps and slices being converted
+func toSlogValueE(i interface{}, visiting map[dumpRef]bool) (value slog.Value, err error) {
+	if v, ok := i.(Value); ok {
+		i = v.value
+	}
+	i = underlying(i)
+	value = slog.AnyValue(nil)
+	err = nil
+
+	if v := reflect.ValueOf(i); v.Kind() == reflect.Map || v.Kind() == reflect.Slice || v.Kind() == reflect.Ptr {
+		ref := dumpRef{v.Type(), v.Pointer()}
+		if visiting[ref] {
+			value = slog.StringValue("<cycle " + v.Type().String() + ">")
+			return
+		}
+		visiting[ref] = true
+		defer delete(visiting, ref)
+	}
+
+	switch s := i.(type) {
+	case nil:
+	case slog.Value:
+		value = s
+	case slog.LogValuer:
+		value = s.LogValue()
+	case string:
+		value = slog.StringValue(s)
+	case bool:
+		value = slog.BoolValue(s)
+	case int:
+		value = slog.IntValue(s)
+	case int64:
+		value = slog.Int64Value(s)
+	case int32, int16, int8:
+		value = slog.Int64Value(ToInt64(s))
+	case uint64:
+		value = slog.Uint64Value(s)
+	case uint, uint32, uint16, uint8:
+		value = slog.Uint64Value(ToUint64(s))
+	case float64:
+		value = slog.Float64Value(s)
+	case float32:
+		value = slog.Float64Value(float64(s))
+	case time.Time:
+		value = slog.TimeValue(s)
+	case time.Duration:
+		value = slog.DurationValue(s)
+	case []uint8:
+		value = slog.StringValue(string(s))
+	case *OrderedMap:
+		attrs := make([]slog.Attr, 0, s.Len())
+		for _, key := range s.keys {
+			val, e := toSlogValueE(s.values[key], visiting)
+			if e != nil {
+				err = e
+				return
+			}
+			attrs = append(attrs, slog.Attr{Key: key, Value: val})
+		}
+		value = slog.GroupValue(attrs...)
+	default:
+		v := reflect.ValueOf(i)
+		switch v.Kind() {
+		case reflect.Map:
+			values := map[string]reflect.Value{}
+			for _, k := range v.MapKeys() {
+				key, e := ToStringE(k.Interface())
+				if e != nil {
+					err = fmt.Errorf("unable to cast %#v of type %T to slog.Value: %w", i, i, e)
+					return
+				}
+				values[key] = v.MapIndex(k)
+			}
+			keys := sortedKeys(values)
+			attrs := make([]slog.Attr, len(keys))
+			for j, key := range keys {
+				val, e := toSlogValueE(values[key].Interface(), visiting)
+				if e != nil {
+					err = e
+					return
+				}
+				attrs[j] = slog.Attr{Key: key, Value: val}
+			}
+			value = slog.GroupValue(attrs...)
+		case reflect.Slice, reflect.Array:
+			a := make([]interface{}, v.Len())
+			for j := range a {
+				val, e := toSlogValueE(v.Index(j).Interface(), visiting)
+				if e != nil {
+					err = e
+					return
+				}
+				a[j] = fromSlogValue(val)
+			}
+			value = slog.AnyValue(a)
+		default:
+			value = slog.AnyValue(i)
+		}
+	}
+	return
+}
+func ToSlogValue(i interface{}) slog.Value {
+	v, _ := ToSlogValueE(i)
+	return v
+}
+
+// FromSlogValue converts slog.Value, slog.Attr or []slog.Attr into Value.
+// Groups and attributes become map[string]interface{}, empty key groups are inlined.
+func FromSlogValue(i interface{}) Value {
+	switch s := i.(type) {
+	case slog.Value:
+		return New(fromSlogValue(s))
+	case slog.Attr:
+		return New(fromSlogAttrs([]slog.Attr{s}))
+	case []slog.Attr:
+		return New(fromSlogAttrs(s))
+	}
+	return New(i)
+}
+
+func fromSlogValue(v slog.Value) interface{} {
+	v = v.Resolve()
+	switch v.Kind() {
+	case slog.KindString:
+		return v.String()
+	case slog.KindInt64:
+		return v.Int64()
+	case slog.KindUint64:
+		return v.Uint64()
+	case slog.KindFloat64:
+		return v.Float64()
+	case slog.KindBool:
+		return v.Bool()
+	case slog.KindDuration:
+		return v.Duration()
+	case slog.KindTime:
+		return v.Time()
+	case slog.KindGroup:
+		return fromSlogAttrs(v.Group())
+	}
+	return v.Any()
+}
+
+func fromSlogAttrs(attrs []slog.Attr) map[string]interface{} {
+	m := map[string]interface{}{}
+	for _, a := range attrs {
+		v := fromSlogValue(a.Value)
+		if group, ok := v.(map[string]interface{}); ok && a.Key == "" {
+			for k, val := range group {
+				m[k] = val
+			}
+			continue
+		}
+		m[a.Key] = v
+	}
+	return m
+}
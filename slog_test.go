@@ -0,0 +1,59 @@
+package value
+
+import (
+	"log/slog"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func slogKeys(v slog.Value) []string {
+	keys := []string{}
+	for _, a := range v.Group() {
+		keys = append(keys, a.Key)
+	}
+	return keys
+}
+
+func TestToSlogValueKeyOrder(t *testing.T) {
+	ordered := NewOrderedMap()
+	ordered.Set("b", 1)
+	ordered.Set("a", 2)
+	reverse := func(keys []string) { sort.Sort(sort.Reverse(sort.StringSlice(keys))) }
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		sortKeys func([]string)
+		want     []string
+	}{
+		{"map sorted", map[string]int{"b": 1, "a": 2, "c": 3}, nil, []string{"a", "b", "c"}},
+		{"map SortKeys", map[string]int{"b": 1, "a": 2, "c": 3}, reverse, []string{"c", "b", "a"}},
+		{"int keys SortKeys", map[int]int{1: 1, 2: 2}, reverse, []string{"2", "1"}},
+		{"ordered map", ordered, reverse, []string{"b", "a"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.sortKeys != nil {
+				defer func(s func([]string)) { SortKeys = s }(SortKeys)
+				SortKeys = test.sortKeys
+			}
+			v, err := ToSlogValueE(test.input)
+			if err != nil {
+				t.Fatalf("ToSlogValueE(%v) error = %v", test.input, err)
+			}
+			if got := slogKeys(v); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ToSlogValueE(%v) keys = %q, want %q", test.input, got, test.want)
+			}
+			kv := []string{}
+			for _, pair := range strings.Split(FormatKeyValues(test.input, KeyValueComma), ",") {
+				kv = append(kv, strings.SplitN(pair, "=", 2)[0])
+			}
+			if !reflect.DeepEqual(kv, test.want) {
+				t.Errorf("FormatKeyValues(%v) keys = %q, want %q", test.input, kv, test.want)
+			}
+		})
+	}
+}
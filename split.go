@@ -0,0 +1,237 @@
+package value
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SplitMode selects how strings are split by slice conversions
+type SplitMode int
+
+const (
+	// SplitFields splits by seperator, without seperator on every rune which is not letter or number
+	SplitFields SplitMode = iota
+	// SplitCSV splits by encoding/csv rules: quoted fields, "" escaped quotes, newlines separate records too.
+	// Seperator, when given, must be a single rune and replaces CSVOptions.Comma.
+	SplitCSV
+)
+
+// StringSplitMode is used by StringSlice, IntSlice, BoolSlice, TimeSlice, ValueSlice and other slice conversions
+// of strings called without SplitOptions, set SplitOptions.Mode to split other way per call
+var StringSplitMode = SplitFields
+
+// CSVOptions configure SplitCSV mode
+type CSVOptions struct {
+	// Comma is field delimiter, ',' when zero
+	Comma rune
+	// TrimSpace removes leading and trailing white space of fields,
+	// white space between closing quote and delimiter is still a parse error
+	TrimSpace bool
+	// SkipEmpty removes empty fields
+	SkipEmpty bool
+	// LazyQuotes allows quotes in unquoted fields and non-doubled quotes in quoted fields
+	LazyQuotes bool
+}
+
+// DefaultCSVOptions are used in SplitCSV mode
+var DefaultCSVOptions = CSVOptions{Comma: ',', TrimSpace: true}
+
+// ErrInvalidSeperator is returned when seperator can not be used as CSV delimiter
+var ErrInvalidSeperator = errors.New("invalid seperator")
+
+// SplitOptions configure how strings are split by slice conversions
+type SplitOptions struct {
+	// Mode is SplitFields or SplitCSV
+	Mode SplitMode
+	// Seperators split string at any of them, the longest wins when several match at the same position.
+	// Without Seperators and Regexp string is split on every rune which is not letter or number,
+	// typed numeric and duration slices (int64, ..., float64, time.Duration) split on commas and white space
+	// keeping signs, decimal points and units, []int keeps the letter or number split.
+	// In SplitCSV mode single seperator of one rune replaces CSVOptions.Comma.
+	Seperators []string
+	// Regexp splits string at matches instead of Seperators
+	Regexp *regexp.Regexp
+	// Limit is maximal number of fields, the last field holds the rest of string, 0 for no limit.
+	// Not used in SplitCSV mode.
+	Limit int
+	// TrimSpace removes leading and trailing white space of fields
+	TrimSpace bool
+	// SkipEmpty removes empty fields
+	SkipEmpty bool
+	// Unquote removes "", '' or `` quotes around fields, "" and `` quoted fields are unescaped by strconv.Unquote
+	Unquote bool
+	// CSV options of SplitCSV mode, DefaultCSVOptions when nil
+	CSV *CSVOptions
+}
+
+// splitOptions converts variadic seperator of slice conversions to SplitOptions
+func splitOptions(seperator []string) SplitOptions {
+	o := SplitOptions{Mode: StringSplitMode}
+	if len(seperator) > 0 {
+		o.Seperators = seperator[:1]
+	}
+	return o
+}
+
+// numberSeperator splits numbers and durations without seperator
+var numberSeperator = regexp.MustCompile(`[\s,]+`)
+
+// numberSplit sets default seperator of typed numeric and duration slices to commas and white space
+func numberSplit(o SplitOptions) SplitOptions {
+	if o.Mode == SplitFields && len(o.Seperators) == 0 && o.Regexp == nil {
+		o.Regexp = numberSeperator
+		o.SkipEmpty = true
+	}
+	return o
+}
+
+// splitString splits s by o
+func splitString(s string, o SplitOptions) ([]string, error) {
+	var fields []string
+	if o.Mode == SplitCSV {
+		c := DefaultCSVOptions
+		if o.CSV != nil {
+			c = *o.CSV
+		}
+		if len(o.Seperators) > 0 {
+			r, size := utf8.DecodeRuneInString(o.Seperators[0])
+			if len(o.Seperators) > 1 || size == 0 || size != len(o.Seperators[0]) {
+				return nil, ErrInvalidSeperator
+			}
+			c.Comma = r
+		}
+		f, err := splitCSV(s, c)
+		if err != nil {
+			return nil, err
+		}
+		fields = f
+	} else {
+		fields = splitFields(s, o)
+	}
+	if !o.TrimSpace && !o.Unquote && !o.SkipEmpty {
+		return fields, nil
+	}
+	value := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if o.TrimSpace {
+			field = strings.TrimSpace(field)
+		}
+		if o.Unquote {
+			field = unquote(field)
+		}
+		if o.SkipEmpty && field == "" {
+			continue
+		}
+		value = append(value, field)
+	}
+	return value, nil
+}
+
+func splitFields(s string, o SplitOptions) []string {
+	n := o.Limit
+	if n <= 0 {
+		n = -1
+	}
+	switch {
+	case o.Regexp != nil:
+		return o.Regexp.Split(s, n)
+	case len(o.Seperators) == 1:
+		return strings.SplitN(s, o.Seperators[0], n)
+	case len(o.Seperators) > 1:
+		return splitAny(s, o.Seperators, n)
+	}
+	if n < 0 {
+		return strings.FieldsFunc(s, isFieldSeperator)
+	}
+	value := []string{}
+	s = strings.TrimLeftFunc(s, isFieldSeperator)
+	for s != "" {
+		end := strings.IndexFunc(s, isFieldSeperator)
+		if len(value) == n-1 || end < 0 {
+			value = append(value, s)
+			break
+		}
+		value = append(value, s[:end])
+		s = strings.TrimLeftFunc(s[end:], isFieldSeperator)
+	}
+	return value
+}
+
+func isFieldSeperator(c rune) bool {
+	return !unicode.IsLetter(c) && !unicode.IsNumber(c)
+}
+
+// splitAny splits s at any of seperators into at most n fields, n < 0 for no limit
+func splitAny(s string, seperators []string, n int) []string {
+	value := []string{}
+	for n < 0 || len(value) < n-1 {
+		at, size := -1, 0
+		for _, sep := range seperators {
+			if sep == "" {
+				continue
+			}
+			if j := strings.Index(s, sep); j >= 0 && (at < 0 || j < at || j == at && len(sep) > size) {
+				at, size = j, len(sep)
+			}
+		}
+		if at < 0 {
+			break
+		}
+		value = append(value, s[:at])
+		s = s[at+size:]
+	}
+	return append(value, s)
+}
+
+// unquote removes matching quotes around s
+func unquote(s string) string {
+	if len(s) < 2 || s[0] != s[len(s)-1] {
+		return s
+	}
+	switch s[0] {
+	case '"', '`':
+		if u, err := strconv.Unquote(s); err == nil {
+			return u
+		}
+	case '\'':
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// splitCSV splits s by encoding/csv rules, fields of all records are joined
+func splitCSV(s string, o CSVOptions) ([]string, error) {
+	r := csv.NewReader(strings.NewReader(s))
+	if o.Comma != 0 {
+		r.Comma = o.Comma
+	}
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = o.LazyQuotes
+	r.TrimLeadingSpace = o.TrimSpace
+	value := []string{}
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, field := range record {
+			if o.TrimSpace {
+				field = strings.TrimSpace(field)
+			}
+			if o.SkipEmpty && field == "" {
+				continue
+			}
+			value = append(value, field)
+		}
+	}
+	return value, nil
+}
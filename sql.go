@@ -0,0 +1,70 @@
+package value
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"time"
+)
+
+// Scan implements the sql.Scanner interface.
+// []byte columns are copied and stored as string, so JSON columns are decoded into maps
+// when asked by Map, MapGet or ValueMap.
+func (v *Value) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		v.value = string(s)
+	case time.Time, string, int64, float64, bool, nil:
+		v.value = s
+	default:
+		v.value = underlying(s)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// Maps, slices and structs are encoded as JSON string.
+func (v Value) Value() (driver.Value, error) {
+	i := underlying(v.value)
+	if i == nil {
+		return nil, nil
+	}
+	if dv, err := driver.DefaultParameterConverter.ConvertValue(i); err == nil {
+		return dv, nil
+	}
+	switch reflect.Indirect(reflect.ValueOf(i)).Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array, reflect.Struct:
+		b, err := New(i).MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	}
+	return ToStringE(i)
+}
+
+// ScanRows loads all rows of result set into maps of column name to Value.
+// Rows are not closed.
+func ScanRows(rows *sql.Rows) ([]map[string]Value, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	result := []map[string]Value{}
+	for rows.Next() {
+		values := make([]Value, len(columns))
+		dest := make([]interface{}, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		row := make(map[string]Value, len(columns))
+		for i, column := range columns {
+			row[column] = values[i]
+		}
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
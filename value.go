@@ -1,6 +1,7 @@
 package value
 
 import (
+	"net/url"
 	"time"
 )
 
@@ -37,7 +38,7 @@ func (v Value) String(defaultValue ...string) string {
 	return ToString(v.value, defaultValue...)
 }
 
-// Bool ...
+// Bool looks up strings in BoolVocabulary, or in boolTrue words if provided
 func (v Value) Bool(boolTrue ...string) bool {
 	return ToBool(v.value, boolTrue...)
 }
@@ -52,6 +53,16 @@ func (v Value) Float32(defaultValue ...float32) float32 {
 	return ToFloat32(v.value, defaultValue...)
 }
 
+// Complex128 ...
+func (v Value) Complex128(defaultValue ...complex128) complex128 {
+	return ToComplex128(v.value, defaultValue...)
+}
+
+// Complex64 ...
+func (v Value) Complex64(defaultValue ...complex64) complex64 {
+	return ToComplex64(v.value, defaultValue...)
+}
+
 // Int64 ...
 func (v Value) Int64(defaultValue ...int64) int64 {
 	return ToInt64(v.value, defaultValue...)
@@ -117,9 +128,14 @@ func (v Value) StringSlice(seperator ...string) []string {
 	return ToStringSlice(v.value, seperator...)
 }
 
+// StringSliceWith ...
+func (v Value) StringSliceWith(o SplitOptions) []string {
+	return ToStringSliceWith(v.value, o)
+}
+
 // Map ...
-func (v Value) Map() map[string]interface{} {
-	return ToMap(v.value)
+func (v Value) Map(options ...MapOptions) map[string]interface{} {
+	return ToMap(v.value, options...)
 }
 
 // MapSlice ...
@@ -137,29 +153,329 @@ func (v Value) Slice(seperator ...string) []interface{} {
 	return ToSlice(v.value, seperator...)
 }
 
+// SliceWith ...
+func (v Value) SliceWith(o SplitOptions) []interface{} {
+	return ToSliceWith(v.value, o)
+}
+
 // IntSlice ...
 func (v Value) IntSlice(seperator ...string) []int {
 	return ToIntSlice(v.value, seperator...)
 }
 
+// IntSliceWith ...
+func (v Value) IntSliceWith(o SplitOptions) []int {
+	return ToIntSliceWith(v.value, o)
+}
+
 // BoolSlice ...
 // boolTrueAndSeperator 1: boolTrue, 2:seperator, empty string "" to skip parameter
 func (v Value) BoolSlice(boolTrueAndSeperator ...string) []bool {
 	return ToBoolSlice(v.value, boolTrueAndSeperator...)
 }
 
+// BoolSliceWith ...
+func (v Value) BoolSliceWith(o SplitOptions, boolTrue ...string) []bool {
+	return ToBoolSliceWith(v.value, o, boolTrue...)
+}
+
 // TimeSlice ...
 // timeFormatAndSeperator 1: timeFormat, 2:seperator, empty string "" to skip parameter
 func (v Value) TimeSlice(timeFormatAndSeperator ...string) []time.Time {
 	return ToTimeSlice(v.value, timeFormatAndSeperator...)
 }
 
+// TimeSliceWith ...
+func (v Value) TimeSliceWith(o SplitOptions, timeFormat ...string) []time.Time {
+	return ToTimeSliceWith(v.value, o, timeFormat...)
+}
+
 // ValueMap ...
-func (v Value) ValueMap() map[string]Value {
-	return ToValueMap(v.value)
+func (v Value) ValueMap(options ...MapOptions) map[string]Value {
+	return ToValueMap(v.value, options...)
 }
 
 // ValueSlice ...
 func (v Value) ValueSlice(seperator ...string) []Value {
 	return ToValueSlice(v.value, seperator...)
 }
+
+// ValueSliceWith ...
+func (v Value) ValueSliceWith(o SplitOptions) []Value {
+	return ToValueSliceWith(v.value, o)
+}
+
+// Quantity ...
+func (v Value) Quantity(targetUnit string) Quantity {
+	return ToQuantity(v.value, targetUnit)
+}
+
+// FormatInt ...
+func (v Value) FormatInt(format IntFormat) string {
+	return FormatInt(v.value, format)
+}
+
+// StringPtr ...
+func (v Value) StringPtr() *string {
+	return ToStringPtr(v.value)
+}
+
+// BoolPtr ...
+func (v Value) BoolPtr() *bool {
+	return ToBoolPtr(v.value)
+}
+
+// Float64Ptr ...
+func (v Value) Float64Ptr() *float64 {
+	return ToFloat64Ptr(v.value)
+}
+
+// Float32Ptr ...
+func (v Value) Float32Ptr() *float32 {
+	return ToFloat32Ptr(v.value)
+}
+
+// Int64Ptr ...
+func (v Value) Int64Ptr() *int64 {
+	return ToInt64Ptr(v.value)
+}
+
+// Int32Ptr ...
+func (v Value) Int32Ptr() *int32 {
+	return ToInt32Ptr(v.value)
+}
+
+// Int16Ptr ...
+func (v Value) Int16Ptr() *int16 {
+	return ToInt16Ptr(v.value)
+}
+
+// Int8Ptr ...
+func (v Value) Int8Ptr() *int8 {
+	return ToInt8Ptr(v.value)
+}
+
+// IntPtr ...
+func (v Value) IntPtr() *int {
+	return ToIntPtr(v.value)
+}
+
+// Uint64Ptr ...
+func (v Value) Uint64Ptr() *uint64 {
+	return ToUint64Ptr(v.value)
+}
+
+// Uint32Ptr ...
+func (v Value) Uint32Ptr() *uint32 {
+	return ToUint32Ptr(v.value)
+}
+
+// Uint16Ptr ...
+func (v Value) Uint16Ptr() *uint16 {
+	return ToUint16Ptr(v.value)
+}
+
+// Uint8Ptr ...
+func (v Value) Uint8Ptr() *uint8 {
+	return ToUint8Ptr(v.value)
+}
+
+// UintPtr ...
+func (v Value) UintPtr() *uint {
+	return ToUintPtr(v.value)
+}
+
+// TimePtr ...
+func (v Value) TimePtr() *time.Time {
+	return ToTimePtr(v.value)
+}
+
+// ConvertTo ...
+func (v Value) ConvertTo(target interface{}) error {
+	return ConvertTo(v.value, target)
+}
+
+// Duration ...
+func (v Value) Duration(defaultValue ...time.Duration) time.Duration {
+	return ToDuration(v.value, defaultValue...)
+}
+
+// Int64Slice ...
+func (v Value) Int64Slice(seperator ...string) []int64 {
+	return ToInt64Slice(v.value, seperator...)
+}
+
+// Int64SliceWith ...
+func (v Value) Int64SliceWith(o SplitOptions) []int64 {
+	return ToInt64SliceWith(v.value, o)
+}
+
+// Int32Slice ...
+func (v Value) Int32Slice(seperator ...string) []int32 {
+	return ToInt32Slice(v.value, seperator...)
+}
+
+// Int32SliceWith ...
+func (v Value) Int32SliceWith(o SplitOptions) []int32 {
+	return ToInt32SliceWith(v.value, o)
+}
+
+// Int16Slice ...
+func (v Value) Int16Slice(seperator ...string) []int16 {
+	return ToInt16Slice(v.value, seperator...)
+}
+
+// Int16SliceWith ...
+func (v Value) Int16SliceWith(o SplitOptions) []int16 {
+	return ToInt16SliceWith(v.value, o)
+}
+
+// Int8Slice ...
+func (v Value) Int8Slice(seperator ...string) []int8 {
+	return ToInt8Slice(v.value, seperator...)
+}
+
+// Int8SliceWith ...
+func (v Value) Int8SliceWith(o SplitOptions) []int8 {
+	return ToInt8SliceWith(v.value, o)
+}
+
+// UintSlice ...
+func (v Value) UintSlice(seperator ...string) []uint {
+	return ToUintSlice(v.value, seperator...)
+}
+
+// UintSliceWith ...
+func (v Value) UintSliceWith(o SplitOptions) []uint {
+	return ToUintSliceWith(v.value, o)
+}
+
+// Uint64Slice ...
+func (v Value) Uint64Slice(seperator ...string) []uint64 {
+	return ToUint64Slice(v.value, seperator...)
+}
+
+// Uint64SliceWith ...
+func (v Value) Uint64SliceWith(o SplitOptions) []uint64 {
+	return ToUint64SliceWith(v.value, o)
+}
+
+// Uint32Slice ...
+func (v Value) Uint32Slice(seperator ...string) []uint32 {
+	return ToUint32Slice(v.value, seperator...)
+}
+
+// Uint32SliceWith ...
+func (v Value) Uint32SliceWith(o SplitOptions) []uint32 {
+	return ToUint32SliceWith(v.value, o)
+}
+
+// Uint16Slice ...
+func (v Value) Uint16Slice(seperator ...string) []uint16 {
+	return ToUint16Slice(v.value, seperator...)
+}
+
+// Uint16SliceWith ...
+func (v Value) Uint16SliceWith(o SplitOptions) []uint16 {
+	return ToUint16SliceWith(v.value, o)
+}
+
+// Uint8Slice ...
+func (v Value) Uint8Slice(seperator ...string) []uint8 {
+	return ToUint8Slice(v.value, seperator...)
+}
+
+// Uint8SliceWith ...
+func (v Value) Uint8SliceWith(o SplitOptions) []uint8 {
+	return ToUint8SliceWith(v.value, o)
+}
+
+// Float64Slice ...
+func (v Value) Float64Slice(seperator ...string) []float64 {
+	return ToFloat64Slice(v.value, seperator...)
+}
+
+// Float64SliceWith ...
+func (v Value) Float64SliceWith(o SplitOptions) []float64 {
+	return ToFloat64SliceWith(v.value, o)
+}
+
+// Float32Slice ...
+func (v Value) Float32Slice(seperator ...string) []float32 {
+	return ToFloat32Slice(v.value, seperator...)
+}
+
+// Float32SliceWith ...
+func (v Value) Float32SliceWith(o SplitOptions) []float32 {
+	return ToFloat32SliceWith(v.value, o)
+}
+
+// DurationSlice ...
+func (v Value) DurationSlice(seperator ...string) []time.Duration {
+	return ToDurationSlice(v.value, seperator...)
+}
+
+// DurationSliceWith ...
+func (v Value) DurationSliceWith(o SplitOptions) []time.Duration {
+	return ToDurationSliceWith(v.value, o)
+}
+
+// StringMap ...
+func (v Value) StringMap() map[string]interface{} {
+	return ToStringMap(v.value)
+}
+
+// StringMapString ...
+func (v Value) StringMapString() map[string]string {
+	return ToStringMapString(v.value)
+}
+
+// StringMapInt ...
+func (v Value) StringMapInt() map[string]int {
+	return ToStringMapInt(v.value)
+}
+
+// StringMapInt64 ...
+func (v Value) StringMapInt64() map[string]int64 {
+	return ToStringMapInt64(v.value)
+}
+
+// StringMapFloat64 ...
+func (v Value) StringMapFloat64() map[string]float64 {
+	return ToStringMapFloat64(v.value)
+}
+
+// StringMapBool ...
+func (v Value) StringMapBool() map[string]bool {
+	return ToStringMapBool(v.value)
+}
+
+// StringMapStringSlice ...
+func (v Value) StringMapStringSlice() map[string][]string {
+	return ToStringMapStringSlice(v.value)
+}
+
+// Entries ...
+func (v Value) Entries() []KeyValue {
+	return ToEntries(v.value)
+}
+
+// QueryMap ...
+func (v Value) QueryMap() map[string]interface{} {
+	return ToQueryMap(v.value)
+}
+
+// URLValues ...
+func (v Value) URLValues(style ...QueryStyle) url.Values {
+	return ToURLValues(v.value, style...)
+}
+
+// KeyValues ...
+func (v Value) KeyValues(f KeyValueFormat) string {
+	return FormatKeyValues(v.value, f)
+}
+
+// OrderedMap ...
+func (v Value) OrderedMap() *OrderedMap {
+	return ToOrderedMap(v.value)
+}
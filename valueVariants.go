@@ -0,0 +1,1368 @@
+package value
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// ConversionError is returned by E methods of Value and used as panic value by Must methods
+type ConversionError struct {
+	Value  interface{}
+	Target string
+	Err    error
+}
+
+func (e *ConversionError) Error() string {
+	return fmt.Sprintf("unable to convert %#v of type %T to %s: %v", e.Value, e.Value, e.Target, e.Err)
+}
+
+// Unwrap returns underlying conversion error
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+// Methods of Value for every target type T:
+//
+//	TE() (T, error) returns conversion result and *ConversionError on failure
+//	TOk() (T, bool) returns false if value is nil or conversion failed
+//	TOr(def T) T returns def if value is nil or conversion failed
+//	MustT() T panics with *ConversionError if conversion failed
+
+func conversionE[T any](v Value, target string, value T, err error) (T, error) {
+	if err != nil {
+		return value, &ConversionError{Value: v.value, Target: target, Err: err}
+	}
+	return value, nil
+}
+
+func conversionOk[T any](v Value, value T, err error) (T, bool) {
+	if err != nil || v.IsNil() {
+		var zero T
+		return zero, false
+	}
+	return value, true
+}
+
+func conversionOr[T any](v Value, def T, value T, err error) T {
+	if err != nil || v.IsNil() {
+		return def
+	}
+	return value
+}
+
+func conversionMust[T any](v Value, target string, value T, err error) T {
+	if err != nil {
+		panic(&ConversionError{Value: v.value, Target: target, Err: err})
+	}
+	return value
+}
+
+// StringE ...
+func (v Value) StringE() (string, error) {
+	value, err := ToStringE(v.value)
+	return conversionE(v, "string", value, err)
+}
+
+// StringOk ...
+func (v Value) StringOk() (string, bool) {
+	value, err := ToStringE(v.value)
+	return conversionOk(v, value, err)
+}
+
+// StringOr ...
+func (v Value) StringOr(def string) string {
+	value, err := ToStringE(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustString ...
+func (v Value) MustString() string {
+	value, err := ToStringE(v.value)
+	return conversionMust(v, "string", value, err)
+}
+
+// BoolE ...
+func (v Value) BoolE(boolTrue ...string) (bool, error) {
+	value, err := ToBoolE(v.value, boolTrue...)
+	return conversionE(v, "bool", value, err)
+}
+
+// BoolOk ...
+func (v Value) BoolOk(boolTrue ...string) (bool, bool) {
+	value, err := ToBoolE(v.value, boolTrue...)
+	return conversionOk(v, value, err)
+}
+
+// BoolOr ...
+func (v Value) BoolOr(def bool, boolTrue ...string) bool {
+	value, err := ToBoolE(v.value, boolTrue...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustBool ...
+func (v Value) MustBool(boolTrue ...string) bool {
+	value, err := ToBoolE(v.value, boolTrue...)
+	return conversionMust(v, "bool", value, err)
+}
+
+// Float64E ...
+func (v Value) Float64E() (float64, error) {
+	value, err := ToFloat64E(v.value)
+	return conversionE(v, "float64", value, err)
+}
+
+// Float64Ok ...
+func (v Value) Float64Ok() (float64, bool) {
+	value, err := ToFloat64E(v.value)
+	return conversionOk(v, value, err)
+}
+
+// Float64Or ...
+func (v Value) Float64Or(def float64) float64 {
+	value, err := ToFloat64E(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustFloat64 ...
+func (v Value) MustFloat64() float64 {
+	value, err := ToFloat64E(v.value)
+	return conversionMust(v, "float64", value, err)
+}
+
+// Float32E ...
+func (v Value) Float32E() (float32, error) {
+	value, err := ToFloat32E(v.value)
+	return conversionE(v, "float32", value, err)
+}
+
+// Float32Ok ...
+func (v Value) Float32Ok() (float32, bool) {
+	value, err := ToFloat32E(v.value)
+	return conversionOk(v, value, err)
+}
+
+// Float32Or ...
+func (v Value) Float32Or(def float32) float32 {
+	value, err := ToFloat32E(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustFloat32 ...
+func (v Value) MustFloat32() float32 {
+	value, err := ToFloat32E(v.value)
+	return conversionMust(v, "float32", value, err)
+}
+
+// Complex128E ...
+func (v Value) Complex128E() (complex128, error) {
+	value, err := ToComplex128E(v.value)
+	return conversionE(v, "complex128", value, err)
+}
+
+// Complex128Ok ...
+func (v Value) Complex128Ok() (complex128, bool) {
+	value, err := ToComplex128E(v.value)
+	return conversionOk(v, value, err)
+}
+
+// Complex128Or ...
+func (v Value) Complex128Or(def complex128) complex128 {
+	value, err := ToComplex128E(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustComplex128 ...
+func (v Value) MustComplex128() complex128 {
+	value, err := ToComplex128E(v.value)
+	return conversionMust(v, "complex128", value, err)
+}
+
+// Complex64E ...
+func (v Value) Complex64E() (complex64, error) {
+	value, err := ToComplex64E(v.value)
+	return conversionE(v, "complex64", value, err)
+}
+
+// Complex64Ok ...
+func (v Value) Complex64Ok() (complex64, bool) {
+	value, err := ToComplex64E(v.value)
+	return conversionOk(v, value, err)
+}
+
+// Complex64Or ...
+func (v Value) Complex64Or(def complex64) complex64 {
+	value, err := ToComplex64E(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustComplex64 ...
+func (v Value) MustComplex64() complex64 {
+	value, err := ToComplex64E(v.value)
+	return conversionMust(v, "complex64", value, err)
+}
+
+// Int64E ...
+func (v Value) Int64E() (int64, error) {
+	value, err := ToInt64E(v.value)
+	return conversionE(v, "int64", value, err)
+}
+
+// Int64Ok ...
+func (v Value) Int64Ok() (int64, bool) {
+	value, err := ToInt64E(v.value)
+	return conversionOk(v, value, err)
+}
+
+// Int64Or ...
+func (v Value) Int64Or(def int64) int64 {
+	value, err := ToInt64E(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustInt64 ...
+func (v Value) MustInt64() int64 {
+	value, err := ToInt64E(v.value)
+	return conversionMust(v, "int64", value, err)
+}
+
+// Int32E ...
+func (v Value) Int32E() (int32, error) {
+	value, err := ToInt32E(v.value)
+	return conversionE(v, "int32", value, err)
+}
+
+// Int32Ok ...
+func (v Value) Int32Ok() (int32, bool) {
+	value, err := ToInt32E(v.value)
+	return conversionOk(v, value, err)
+}
+
+// Int32Or ...
+func (v Value) Int32Or(def int32) int32 {
+	value, err := ToInt32E(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustInt32 ...
+func (v Value) MustInt32() int32 {
+	value, err := ToInt32E(v.value)
+	return conversionMust(v, "int32", value, err)
+}
+
+// Int16E ...
+func (v Value) Int16E() (int16, error) {
+	value, err := ToInt16E(v.value)
+	return conversionE(v, "int16", value, err)
+}
+
+// Int16Ok ...
+func (v Value) Int16Ok() (int16, bool) {
+	value, err := ToInt16E(v.value)
+	return conversionOk(v, value, err)
+}
+
+// Int16Or ...
+func (v Value) Int16Or(def int16) int16 {
+	value, err := ToInt16E(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustInt16 ...
+func (v Value) MustInt16() int16 {
+	value, err := ToInt16E(v.value)
+	return conversionMust(v, "int16", value, err)
+}
+
+// Int8E ...
+func (v Value) Int8E() (int8, error) {
+	value, err := ToInt8E(v.value)
+	return conversionE(v, "int8", value, err)
+}
+
+// Int8Ok ...
+func (v Value) Int8Ok() (int8, bool) {
+	value, err := ToInt8E(v.value)
+	return conversionOk(v, value, err)
+}
+
+// Int8Or ...
+func (v Value) Int8Or(def int8) int8 {
+	value, err := ToInt8E(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustInt8 ...
+func (v Value) MustInt8() int8 {
+	value, err := ToInt8E(v.value)
+	return conversionMust(v, "int8", value, err)
+}
+
+// IntE ...
+func (v Value) IntE() (int, error) {
+	value, err := ToIntE(v.value)
+	return conversionE(v, "int", value, err)
+}
+
+// IntOk ...
+func (v Value) IntOk() (int, bool) {
+	value, err := ToIntE(v.value)
+	return conversionOk(v, value, err)
+}
+
+// IntOr ...
+func (v Value) IntOr(def int) int {
+	value, err := ToIntE(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustInt ...
+func (v Value) MustInt() int {
+	value, err := ToIntE(v.value)
+	return conversionMust(v, "int", value, err)
+}
+
+// Uint64E ...
+func (v Value) Uint64E() (uint64, error) {
+	value, err := ToUint64E(v.value)
+	return conversionE(v, "uint64", value, err)
+}
+
+// Uint64Ok ...
+func (v Value) Uint64Ok() (uint64, bool) {
+	value, err := ToUint64E(v.value)
+	return conversionOk(v, value, err)
+}
+
+// Uint64Or ...
+func (v Value) Uint64Or(def uint64) uint64 {
+	value, err := ToUint64E(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustUint64 ...
+func (v Value) MustUint64() uint64 {
+	value, err := ToUint64E(v.value)
+	return conversionMust(v, "uint64", value, err)
+}
+
+// Uint32E ...
+func (v Value) Uint32E() (uint32, error) {
+	value, err := ToUint32E(v.value)
+	return conversionE(v, "uint32", value, err)
+}
+
+// Uint32Ok ...
+func (v Value) Uint32Ok() (uint32, bool) {
+	value, err := ToUint32E(v.value)
+	return conversionOk(v, value, err)
+}
+
+// Uint32Or ...
+func (v Value) Uint32Or(def uint32) uint32 {
+	value, err := ToUint32E(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustUint32 ...
+func (v Value) MustUint32() uint32 {
+	value, err := ToUint32E(v.value)
+	return conversionMust(v, "uint32", value, err)
+}
+
+// Uint16E ...
+func (v Value) Uint16E() (uint16, error) {
+	value, err := ToUint16E(v.value)
+	return conversionE(v, "uint16", value, err)
+}
+
+// Uint16Ok ...
+func (v Value) Uint16Ok() (uint16, bool) {
+	value, err := ToUint16E(v.value)
+	return conversionOk(v, value, err)
+}
+
+// Uint16Or ...
+func (v Value) Uint16Or(def uint16) uint16 {
+	value, err := ToUint16E(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustUint16 ...
+func (v Value) MustUint16() uint16 {
+	value, err := ToUint16E(v.value)
+	return conversionMust(v, "uint16", value, err)
+}
+
+// Uint8E ...
+func (v Value) Uint8E() (uint8, error) {
+	value, err := ToUint8E(v.value)
+	return conversionE(v, "uint8", value, err)
+}
+
+// Uint8Ok ...
+func (v Value) Uint8Ok() (uint8, bool) {
+	value, err := ToUint8E(v.value)
+	return conversionOk(v, value, err)
+}
+
+// Uint8Or ...
+func (v Value) Uint8Or(def uint8) uint8 {
+	value, err := ToUint8E(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustUint8 ...
+func (v Value) MustUint8() uint8 {
+	value, err := ToUint8E(v.value)
+	return conversionMust(v, "uint8", value, err)
+}
+
+// UintE ...
+func (v Value) UintE() (uint, error) {
+	value, err := ToUintE(v.value)
+	return conversionE(v, "uint", value, err)
+}
+
+// UintOk ...
+func (v Value) UintOk() (uint, bool) {
+	value, err := ToUintE(v.value)
+	return conversionOk(v, value, err)
+}
+
+// UintOr ...
+func (v Value) UintOr(def uint) uint {
+	value, err := ToUintE(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustUint ...
+func (v Value) MustUint() uint {
+	value, err := ToUintE(v.value)
+	return conversionMust(v, "uint", value, err)
+}
+
+// TimeE ...
+func (v Value) TimeE(timeFormat ...string) (time.Time, error) {
+	value, err := ToTimeE(v.value, timeFormat...)
+	return conversionE(v, "time.Time", value, err)
+}
+
+// TimeOk ...
+func (v Value) TimeOk(timeFormat ...string) (time.Time, bool) {
+	value, err := ToTimeE(v.value, timeFormat...)
+	return conversionOk(v, value, err)
+}
+
+// TimeOr ...
+func (v Value) TimeOr(def time.Time, timeFormat ...string) time.Time {
+	value, err := ToTimeE(v.value, timeFormat...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustTime ...
+func (v Value) MustTime(timeFormat ...string) time.Time {
+	value, err := ToTimeE(v.value, timeFormat...)
+	return conversionMust(v, "time.Time", value, err)
+}
+
+// TimeStringE ...
+func (v Value) TimeStringE(timeFormat ...string) (string, error) {
+	value, err := ToTimeStringE(v.value, timeFormat...)
+	return conversionE(v, "string", value, err)
+}
+
+// TimeStringOk ...
+func (v Value) TimeStringOk(timeFormat ...string) (string, bool) {
+	value, err := ToTimeStringE(v.value, timeFormat...)
+	return conversionOk(v, value, err)
+}
+
+// TimeStringOr ...
+func (v Value) TimeStringOr(def string, timeFormat ...string) string {
+	value, err := ToTimeStringE(v.value, timeFormat...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustTimeString ...
+func (v Value) MustTimeString(timeFormat ...string) string {
+	value, err := ToTimeStringE(v.value, timeFormat...)
+	return conversionMust(v, "string", value, err)
+}
+
+// StringSliceE ...
+func (v Value) StringSliceE(seperator ...string) ([]string, error) {
+	value, err := ToStringSliceE(v.value, seperator...)
+	return conversionE(v, "[]string", value, err)
+}
+
+// StringSliceOk ...
+func (v Value) StringSliceOk(seperator ...string) ([]string, bool) {
+	value, err := ToStringSliceE(v.value, seperator...)
+	return conversionOk(v, value, err)
+}
+
+// StringSliceOr ...
+func (v Value) StringSliceOr(def []string, seperator ...string) []string {
+	value, err := ToStringSliceE(v.value, seperator...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustStringSlice ...
+func (v Value) MustStringSlice(seperator ...string) []string {
+	value, err := ToStringSliceE(v.value, seperator...)
+	return conversionMust(v, "[]string", value, err)
+}
+
+// StringSliceWithE ...
+func (v Value) StringSliceWithE(o SplitOptions) ([]string, error) {
+	value, err := ToStringSliceWithE(v.value, o)
+	return conversionE(v, "[]string", value, err)
+}
+
+// SliceE ...
+func (v Value) SliceE(seperator ...string) ([]interface{}, error) {
+	value, err := ToSliceE(v.value, seperator...)
+	return conversionE(v, "[]interface{}", value, err)
+}
+
+// SliceOk ...
+func (v Value) SliceOk(seperator ...string) ([]interface{}, bool) {
+	value, err := ToSliceE(v.value, seperator...)
+	return conversionOk(v, value, err)
+}
+
+// SliceOr ...
+func (v Value) SliceOr(def []interface{}, seperator ...string) []interface{} {
+	value, err := ToSliceE(v.value, seperator...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustSlice ...
+func (v Value) MustSlice(seperator ...string) []interface{} {
+	value, err := ToSliceE(v.value, seperator...)
+	return conversionMust(v, "[]interface{}", value, err)
+}
+
+// SliceWithE ...
+func (v Value) SliceWithE(o SplitOptions) ([]interface{}, error) {
+	value, err := ToSliceWithE(v.value, o)
+	return conversionE(v, "[]interface{}", value, err)
+}
+
+// IntSliceE ...
+func (v Value) IntSliceE(seperator ...string) ([]int, error) {
+	value, err := ToIntSliceE(v.value, seperator...)
+	return conversionE(v, "[]int", value, err)
+}
+
+// IntSliceOk ...
+func (v Value) IntSliceOk(seperator ...string) ([]int, bool) {
+	value, err := ToIntSliceE(v.value, seperator...)
+	return conversionOk(v, value, err)
+}
+
+// IntSliceOr ...
+func (v Value) IntSliceOr(def []int, seperator ...string) []int {
+	value, err := ToIntSliceE(v.value, seperator...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustIntSlice ...
+func (v Value) MustIntSlice(seperator ...string) []int {
+	value, err := ToIntSliceE(v.value, seperator...)
+	return conversionMust(v, "[]int", value, err)
+}
+
+// IntSliceWithE ...
+func (v Value) IntSliceWithE(o SplitOptions) ([]int, error) {
+	value, err := ToIntSliceWithE(v.value, o)
+	return conversionE(v, "[]int", value, err)
+}
+
+// BoolSliceE ...
+func (v Value) BoolSliceE(boolTrueAndSeperator ...string) ([]bool, error) {
+	value, err := ToBoolSliceE(v.value, boolTrueAndSeperator...)
+	return conversionE(v, "[]bool", value, err)
+}
+
+// BoolSliceOk ...
+func (v Value) BoolSliceOk(boolTrueAndSeperator ...string) ([]bool, bool) {
+	value, err := ToBoolSliceE(v.value, boolTrueAndSeperator...)
+	return conversionOk(v, value, err)
+}
+
+// BoolSliceOr ...
+func (v Value) BoolSliceOr(def []bool, boolTrueAndSeperator ...string) []bool {
+	value, err := ToBoolSliceE(v.value, boolTrueAndSeperator...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustBoolSlice ...
+func (v Value) MustBoolSlice(boolTrueAndSeperator ...string) []bool {
+	value, err := ToBoolSliceE(v.value, boolTrueAndSeperator...)
+	return conversionMust(v, "[]bool", value, err)
+}
+
+// BoolSliceWithE ...
+func (v Value) BoolSliceWithE(o SplitOptions, boolTrue ...string) ([]bool, error) {
+	value, err := ToBoolSliceWithE(v.value, o, boolTrue...)
+	return conversionE(v, "[]bool", value, err)
+}
+
+// TimeSliceE ...
+func (v Value) TimeSliceE(timeFormatAndSeperator ...string) ([]time.Time, error) {
+	value, err := ToTimeSliceE(v.value, timeFormatAndSeperator...)
+	return conversionE(v, "[]time.Time", value, err)
+}
+
+// TimeSliceOk ...
+func (v Value) TimeSliceOk(timeFormatAndSeperator ...string) ([]time.Time, bool) {
+	value, err := ToTimeSliceE(v.value, timeFormatAndSeperator...)
+	return conversionOk(v, value, err)
+}
+
+// TimeSliceOr ...
+func (v Value) TimeSliceOr(def []time.Time, timeFormatAndSeperator ...string) []time.Time {
+	value, err := ToTimeSliceE(v.value, timeFormatAndSeperator...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustTimeSlice ...
+func (v Value) MustTimeSlice(timeFormatAndSeperator ...string) []time.Time {
+	value, err := ToTimeSliceE(v.value, timeFormatAndSeperator...)
+	return conversionMust(v, "[]time.Time", value, err)
+}
+
+// TimeSliceWithE ...
+// Strings are split by o, elements are parsed with timeFormat[0], or with known formats when it is not provided.
+func (v Value) TimeSliceWithE(o SplitOptions, timeFormat ...string) ([]time.Time, error) {
+	value, err := ToTimeSliceWithE(v.value, o, timeFormat...)
+	return conversionE(v, "[]time.Time", value, err)
+}
+
+// ValueSliceE ...
+func (v Value) ValueSliceE(seperator ...string) ([]Value, error) {
+	value, err := ToValueSliceE(v.value, seperator...)
+	return conversionE(v, "[]Value", value, err)
+}
+
+// ValueSliceOk ...
+func (v Value) ValueSliceOk(seperator ...string) ([]Value, bool) {
+	value, err := ToValueSliceE(v.value, seperator...)
+	return conversionOk(v, value, err)
+}
+
+// ValueSliceOr ...
+func (v Value) ValueSliceOr(def []Value, seperator ...string) []Value {
+	value, err := ToValueSliceE(v.value, seperator...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustValueSlice ...
+func (v Value) MustValueSlice(seperator ...string) []Value {
+	value, err := ToValueSliceE(v.value, seperator...)
+	return conversionMust(v, "[]Value", value, err)
+}
+
+// ValueSliceWithE ...
+func (v Value) ValueSliceWithE(o SplitOptions) ([]Value, error) {
+	value, err := ToValueSliceWithE(v.value, o)
+	return conversionE(v, "[]Value", value, err)
+}
+
+// MapE ...
+func (v Value) MapE(options ...MapOptions) (map[string]interface{}, error) {
+	value, err := ToMapE(v.value, options...)
+	return conversionE(v, "map[string]interface{}", value, err)
+}
+
+// MapOk ...
+func (v Value) MapOk(options ...MapOptions) (map[string]interface{}, bool) {
+	value, err := ToMapE(v.value, options...)
+	return conversionOk(v, value, err)
+}
+
+// MapOr ...
+func (v Value) MapOr(def map[string]interface{}, options ...MapOptions) map[string]interface{} {
+	value, err := ToMapE(v.value, options...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustMap ...
+func (v Value) MustMap(options ...MapOptions) map[string]interface{} {
+	value, err := ToMapE(v.value, options...)
+	return conversionMust(v, "map[string]interface{}", value, err)
+}
+
+// MapSliceE ...
+func (v Value) MapSliceE() ([]map[string]interface{}, error) {
+	value, err := ToMapSliceE(v.value)
+	return conversionE(v, "[]map[string]interface{}", value, err)
+}
+
+// MapSliceOk ...
+func (v Value) MapSliceOk() ([]map[string]interface{}, bool) {
+	value, err := ToMapSliceE(v.value)
+	return conversionOk(v, value, err)
+}
+
+// MapSliceOr ...
+func (v Value) MapSliceOr(def []map[string]interface{}) []map[string]interface{} {
+	value, err := ToMapSliceE(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustMapSlice ...
+func (v Value) MustMapSlice() []map[string]interface{} {
+	value, err := ToMapSliceE(v.value)
+	return conversionMust(v, "[]map[string]interface{}", value, err)
+}
+
+// ValueMapE ...
+func (v Value) ValueMapE(options ...MapOptions) (map[string]Value, error) {
+	value, err := ToValueMapE(v.value, options...)
+	return conversionE(v, "map[string]Value", value, err)
+}
+
+// ValueMapOk ...
+func (v Value) ValueMapOk(options ...MapOptions) (map[string]Value, bool) {
+	value, err := ToValueMapE(v.value, options...)
+	return conversionOk(v, value, err)
+}
+
+// ValueMapOr ...
+func (v Value) ValueMapOr(def map[string]Value, options ...MapOptions) map[string]Value {
+	value, err := ToValueMapE(v.value, options...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustValueMap ...
+func (v Value) MustValueMap(options ...MapOptions) map[string]Value {
+	value, err := ToValueMapE(v.value, options...)
+	return conversionMust(v, "map[string]Value", value, err)
+}
+
+// OrderedMapE ...
+func (v Value) OrderedMapE() (*OrderedMap, error) {
+	value, err := ToOrderedMapE(v.value)
+	return conversionE(v, "*OrderedMap", value, err)
+}
+
+// OrderedMapOk ...
+func (v Value) OrderedMapOk() (*OrderedMap, bool) {
+	value, err := ToOrderedMapE(v.value)
+	return conversionOk(v, value, err)
+}
+
+// OrderedMapOr ...
+func (v Value) OrderedMapOr(def *OrderedMap) *OrderedMap {
+	value, err := ToOrderedMapE(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustOrderedMap ...
+func (v Value) MustOrderedMap() *OrderedMap {
+	value, err := ToOrderedMapE(v.value)
+	return conversionMust(v, "*OrderedMap", value, err)
+}
+
+// DurationE ...
+func (v Value) DurationE() (time.Duration, error) {
+	value, err := ToDurationE(v.value)
+	return conversionE(v, "time.Duration", value, err)
+}
+
+// DurationOk ...
+func (v Value) DurationOk() (time.Duration, bool) {
+	value, err := ToDurationE(v.value)
+	return conversionOk(v, value, err)
+}
+
+// DurationOr ...
+func (v Value) DurationOr(def time.Duration) time.Duration {
+	value, err := ToDurationE(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustDuration ...
+func (v Value) MustDuration() time.Duration {
+	value, err := ToDurationE(v.value)
+	return conversionMust(v, "time.Duration", value, err)
+}
+
+// Int64SliceE ...
+func (v Value) Int64SliceE(seperator ...string) ([]int64, error) {
+	value, err := ToInt64SliceE(v.value, seperator...)
+	return conversionE(v, "[]int64", value, err)
+}
+
+// Int64SliceOk ...
+func (v Value) Int64SliceOk(seperator ...string) ([]int64, bool) {
+	value, err := ToInt64SliceE(v.value, seperator...)
+	return conversionOk(v, value, err)
+}
+
+// Int64SliceOr ...
+func (v Value) Int64SliceOr(def []int64, seperator ...string) []int64 {
+	value, err := ToInt64SliceE(v.value, seperator...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustInt64Slice ...
+func (v Value) MustInt64Slice(seperator ...string) []int64 {
+	value, err := ToInt64SliceE(v.value, seperator...)
+	return conversionMust(v, "[]int64", value, err)
+}
+
+// Int64SliceWithE ...
+func (v Value) Int64SliceWithE(o SplitOptions) ([]int64, error) {
+	value, err := ToInt64SliceWithE(v.value, o)
+	return conversionE(v, "[]int64", value, err)
+}
+
+// Int32SliceE ...
+func (v Value) Int32SliceE(seperator ...string) ([]int32, error) {
+	value, err := ToInt32SliceE(v.value, seperator...)
+	return conversionE(v, "[]int32", value, err)
+}
+
+// Int32SliceOk ...
+func (v Value) Int32SliceOk(seperator ...string) ([]int32, bool) {
+	value, err := ToInt32SliceE(v.value, seperator...)
+	return conversionOk(v, value, err)
+}
+
+// Int32SliceOr ...
+func (v Value) Int32SliceOr(def []int32, seperator ...string) []int32 {
+	value, err := ToInt32SliceE(v.value, seperator...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustInt32Slice ...
+func (v Value) MustInt32Slice(seperator ...string) []int32 {
+	value, err := ToInt32SliceE(v.value, seperator...)
+	return conversionMust(v, "[]int32", value, err)
+}
+
+// Int32SliceWithE ...
+func (v Value) Int32SliceWithE(o SplitOptions) ([]int32, error) {
+	value, err := ToInt32SliceWithE(v.value, o)
+	return conversionE(v, "[]int32", value, err)
+}
+
+// Int16SliceE ...
+func (v Value) Int16SliceE(seperator ...string) ([]int16, error) {
+	value, err := ToInt16SliceE(v.value, seperator...)
+	return conversionE(v, "[]int16", value, err)
+}
+
+// Int16SliceOk ...
+func (v Value) Int16SliceOk(seperator ...string) ([]int16, bool) {
+	value, err := ToInt16SliceE(v.value, seperator...)
+	return conversionOk(v, value, err)
+}
+
+// Int16SliceOr ...
+func (v Value) Int16SliceOr(def []int16, seperator ...string) []int16 {
+	value, err := ToInt16SliceE(v.value, seperator...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustInt16Slice ...
+func (v Value) MustInt16Slice(seperator ...string) []int16 {
+	value, err := ToInt16SliceE(v.value, seperator...)
+	return conversionMust(v, "[]int16", value, err)
+}
+
+// Int16SliceWithE ...
+func (v Value) Int16SliceWithE(o SplitOptions) ([]int16, error) {
+	value, err := ToInt16SliceWithE(v.value, o)
+	return conversionE(v, "[]int16", value, err)
+}
+
+// Int8SliceE ...
+func (v Value) Int8SliceE(seperator ...string) ([]int8, error) {
+	value, err := ToInt8SliceE(v.value, seperator...)
+	return conversionE(v, "[]int8", value, err)
+}
+
+// Int8SliceOk ...
+func (v Value) Int8SliceOk(seperator ...string) ([]int8, bool) {
+	value, err := ToInt8SliceE(v.value, seperator...)
+	return conversionOk(v, value, err)
+}
+
+// Int8SliceOr ...
+func (v Value) Int8SliceOr(def []int8, seperator ...string) []int8 {
+	value, err := ToInt8SliceE(v.value, seperator...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustInt8Slice ...
+func (v Value) MustInt8Slice(seperator ...string) []int8 {
+	value, err := ToInt8SliceE(v.value, seperator...)
+	return conversionMust(v, "[]int8", value, err)
+}
+
+// Int8SliceWithE ...
+func (v Value) Int8SliceWithE(o SplitOptions) ([]int8, error) {
+	value, err := ToInt8SliceWithE(v.value, o)
+	return conversionE(v, "[]int8", value, err)
+}
+
+// UintSliceE ...
+func (v Value) UintSliceE(seperator ...string) ([]uint, error) {
+	value, err := ToUintSliceE(v.value, seperator...)
+	return conversionE(v, "[]uint", value, err)
+}
+
+// UintSliceOk ...
+func (v Value) UintSliceOk(seperator ...string) ([]uint, bool) {
+	value, err := ToUintSliceE(v.value, seperator...)
+	return conversionOk(v, value, err)
+}
+
+// UintSliceOr ...
+func (v Value) UintSliceOr(def []uint, seperator ...string) []uint {
+	value, err := ToUintSliceE(v.value, seperator...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustUintSlice ...
+func (v Value) MustUintSlice(seperator ...string) []uint {
+	value, err := ToUintSliceE(v.value, seperator...)
+	return conversionMust(v, "[]uint", value, err)
+}
+
+// UintSliceWithE ...
+func (v Value) UintSliceWithE(o SplitOptions) ([]uint, error) {
+	value, err := ToUintSliceWithE(v.value, o)
+	return conversionE(v, "[]uint", value, err)
+}
+
+// Uint64SliceE ...
+func (v Value) Uint64SliceE(seperator ...string) ([]uint64, error) {
+	value, err := ToUint64SliceE(v.value, seperator...)
+	return conversionE(v, "[]uint64", value, err)
+}
+
+// Uint64SliceOk ...
+func (v Value) Uint64SliceOk(seperator ...string) ([]uint64, bool) {
+	value, err := ToUint64SliceE(v.value, seperator...)
+	return conversionOk(v, value, err)
+}
+
+// Uint64SliceOr ...
+func (v Value) Uint64SliceOr(def []uint64, seperator ...string) []uint64 {
+	value, err := ToUint64SliceE(v.value, seperator...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustUint64Slice ...
+func (v Value) MustUint64Slice(seperator ...string) []uint64 {
+	value, err := ToUint64SliceE(v.value, seperator...)
+	return conversionMust(v, "[]uint64", value, err)
+}
+
+// Uint64SliceWithE ...
+func (v Value) Uint64SliceWithE(o SplitOptions) ([]uint64, error) {
+	value, err := ToUint64SliceWithE(v.value, o)
+	return conversionE(v, "[]uint64", value, err)
+}
+
+// Uint32SliceE ...
+func (v Value) Uint32SliceE(seperator ...string) ([]uint32, error) {
+	value, err := ToUint32SliceE(v.value, seperator...)
+	return conversionE(v, "[]uint32", value, err)
+}
+
+// Uint32SliceOk ...
+func (v Value) Uint32SliceOk(seperator ...string) ([]uint32, bool) {
+	value, err := ToUint32SliceE(v.value, seperator...)
+	return conversionOk(v, value, err)
+}
+
+// Uint32SliceOr ...
+func (v Value) Uint32SliceOr(def []uint32, seperator ...string) []uint32 {
+	value, err := ToUint32SliceE(v.value, seperator...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustUint32Slice ...
+func (v Value) MustUint32Slice(seperator ...string) []uint32 {
+	value, err := ToUint32SliceE(v.value, seperator...)
+	return conversionMust(v, "[]uint32", value, err)
+}
+
+// Uint32SliceWithE ...
+func (v Value) Uint32SliceWithE(o SplitOptions) ([]uint32, error) {
+	value, err := ToUint32SliceWithE(v.value, o)
+	return conversionE(v, "[]uint32", value, err)
+}
+
+// Uint16SliceE ...
+func (v Value) Uint16SliceE(seperator ...string) ([]uint16, error) {
+	value, err := ToUint16SliceE(v.value, seperator...)
+	return conversionE(v, "[]uint16", value, err)
+}
+
+// Uint16SliceOk ...
+func (v Value) Uint16SliceOk(seperator ...string) ([]uint16, bool) {
+	value, err := ToUint16SliceE(v.value, seperator...)
+	return conversionOk(v, value, err)
+}
+
+// Uint16SliceOr ...
+func (v Value) Uint16SliceOr(def []uint16, seperator ...string) []uint16 {
+	value, err := ToUint16SliceE(v.value, seperator...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustUint16Slice ...
+func (v Value) MustUint16Slice(seperator ...string) []uint16 {
+	value, err := ToUint16SliceE(v.value, seperator...)
+	return conversionMust(v, "[]uint16", value, err)
+}
+
+// Uint16SliceWithE ...
+func (v Value) Uint16SliceWithE(o SplitOptions) ([]uint16, error) {
+	value, err := ToUint16SliceWithE(v.value, o)
+	return conversionE(v, "[]uint16", value, err)
+}
+
+// Uint8SliceE ...
+func (v Value) Uint8SliceE(seperator ...string) ([]uint8, error) {
+	value, err := ToUint8SliceE(v.value, seperator...)
+	return conversionE(v, "[]uint8", value, err)
+}
+
+// Uint8SliceOk ...
+func (v Value) Uint8SliceOk(seperator ...string) ([]uint8, bool) {
+	value, err := ToUint8SliceE(v.value, seperator...)
+	return conversionOk(v, value, err)
+}
+
+// Uint8SliceOr ...
+func (v Value) Uint8SliceOr(def []uint8, seperator ...string) []uint8 {
+	value, err := ToUint8SliceE(v.value, seperator...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustUint8Slice ...
+func (v Value) MustUint8Slice(seperator ...string) []uint8 {
+	value, err := ToUint8SliceE(v.value, seperator...)
+	return conversionMust(v, "[]uint8", value, err)
+}
+
+// Uint8SliceWithE ...
+func (v Value) Uint8SliceWithE(o SplitOptions) ([]uint8, error) {
+	value, err := ToUint8SliceWithE(v.value, o)
+	return conversionE(v, "[]uint8", value, err)
+}
+
+// Float64SliceE ...
+func (v Value) Float64SliceE(seperator ...string) ([]float64, error) {
+	value, err := ToFloat64SliceE(v.value, seperator...)
+	return conversionE(v, "[]float64", value, err)
+}
+
+// Float64SliceOk ...
+func (v Value) Float64SliceOk(seperator ...string) ([]float64, bool) {
+	value, err := ToFloat64SliceE(v.value, seperator...)
+	return conversionOk(v, value, err)
+}
+
+// Float64SliceOr ...
+func (v Value) Float64SliceOr(def []float64, seperator ...string) []float64 {
+	value, err := ToFloat64SliceE(v.value, seperator...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustFloat64Slice ...
+func (v Value) MustFloat64Slice(seperator ...string) []float64 {
+	value, err := ToFloat64SliceE(v.value, seperator...)
+	return conversionMust(v, "[]float64", value, err)
+}
+
+// Float64SliceWithE ...
+func (v Value) Float64SliceWithE(o SplitOptions) ([]float64, error) {
+	value, err := ToFloat64SliceWithE(v.value, o)
+	return conversionE(v, "[]float64", value, err)
+}
+
+// Float32SliceE ...
+func (v Value) Float32SliceE(seperator ...string) ([]float32, error) {
+	value, err := ToFloat32SliceE(v.value, seperator...)
+	return conversionE(v, "[]float32", value, err)
+}
+
+// Float32SliceOk ...
+func (v Value) Float32SliceOk(seperator ...string) ([]float32, bool) {
+	value, err := ToFloat32SliceE(v.value, seperator...)
+	return conversionOk(v, value, err)
+}
+
+// Float32SliceOr ...
+func (v Value) Float32SliceOr(def []float32, seperator ...string) []float32 {
+	value, err := ToFloat32SliceE(v.value, seperator...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustFloat32Slice ...
+func (v Value) MustFloat32Slice(seperator ...string) []float32 {
+	value, err := ToFloat32SliceE(v.value, seperator...)
+	return conversionMust(v, "[]float32", value, err)
+}
+
+// Float32SliceWithE ...
+func (v Value) Float32SliceWithE(o SplitOptions) ([]float32, error) {
+	value, err := ToFloat32SliceWithE(v.value, o)
+	return conversionE(v, "[]float32", value, err)
+}
+
+// DurationSliceE ...
+func (v Value) DurationSliceE(seperator ...string) ([]time.Duration, error) {
+	value, err := ToDurationSliceE(v.value, seperator...)
+	return conversionE(v, "[]time.Duration", value, err)
+}
+
+// DurationSliceOk ...
+func (v Value) DurationSliceOk(seperator ...string) ([]time.Duration, bool) {
+	value, err := ToDurationSliceE(v.value, seperator...)
+	return conversionOk(v, value, err)
+}
+
+// DurationSliceOr ...
+func (v Value) DurationSliceOr(def []time.Duration, seperator ...string) []time.Duration {
+	value, err := ToDurationSliceE(v.value, seperator...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustDurationSlice ...
+func (v Value) MustDurationSlice(seperator ...string) []time.Duration {
+	value, err := ToDurationSliceE(v.value, seperator...)
+	return conversionMust(v, "[]time.Duration", value, err)
+}
+
+// DurationSliceWithE ...
+func (v Value) DurationSliceWithE(o SplitOptions) ([]time.Duration, error) {
+	value, err := ToDurationSliceWithE(v.value, o)
+	return conversionE(v, "[]time.Duration", value, err)
+}
+
+// StringMapE ...
+func (v Value) StringMapE() (map[string]interface{}, error) {
+	value, err := ToStringMapE(v.value)
+	return conversionE(v, "map[string]interface{}", value, err)
+}
+
+// StringMapOk ...
+func (v Value) StringMapOk() (map[string]interface{}, bool) {
+	value, err := ToStringMapE(v.value)
+	return conversionOk(v, value, err)
+}
+
+// StringMapOr ...
+func (v Value) StringMapOr(def map[string]interface{}) map[string]interface{} {
+	value, err := ToStringMapE(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustStringMap ...
+func (v Value) MustStringMap() map[string]interface{} {
+	value, err := ToStringMapE(v.value)
+	return conversionMust(v, "map[string]interface{}", value, err)
+}
+
+// StringMapStringE ...
+func (v Value) StringMapStringE() (map[string]string, error) {
+	value, err := ToStringMapStringE(v.value)
+	return conversionE(v, "map[string]string", value, err)
+}
+
+// StringMapStringOk ...
+func (v Value) StringMapStringOk() (map[string]string, bool) {
+	value, err := ToStringMapStringE(v.value)
+	return conversionOk(v, value, err)
+}
+
+// StringMapStringOr ...
+func (v Value) StringMapStringOr(def map[string]string) map[string]string {
+	value, err := ToStringMapStringE(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustStringMapString ...
+func (v Value) MustStringMapString() map[string]string {
+	value, err := ToStringMapStringE(v.value)
+	return conversionMust(v, "map[string]string", value, err)
+}
+
+// StringMapIntE ...
+func (v Value) StringMapIntE() (map[string]int, error) {
+	value, err := ToStringMapIntE(v.value)
+	return conversionE(v, "map[string]int", value, err)
+}
+
+// StringMapIntOk ...
+func (v Value) StringMapIntOk() (map[string]int, bool) {
+	value, err := ToStringMapIntE(v.value)
+	return conversionOk(v, value, err)
+}
+
+// StringMapIntOr ...
+func (v Value) StringMapIntOr(def map[string]int) map[string]int {
+	value, err := ToStringMapIntE(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustStringMapInt ...
+func (v Value) MustStringMapInt() map[string]int {
+	value, err := ToStringMapIntE(v.value)
+	return conversionMust(v, "map[string]int", value, err)
+}
+
+// StringMapInt64E ...
+func (v Value) StringMapInt64E() (map[string]int64, error) {
+	value, err := ToStringMapInt64E(v.value)
+	return conversionE(v, "map[string]int64", value, err)
+}
+
+// StringMapInt64Ok ...
+func (v Value) StringMapInt64Ok() (map[string]int64, bool) {
+	value, err := ToStringMapInt64E(v.value)
+	return conversionOk(v, value, err)
+}
+
+// StringMapInt64Or ...
+func (v Value) StringMapInt64Or(def map[string]int64) map[string]int64 {
+	value, err := ToStringMapInt64E(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustStringMapInt64 ...
+func (v Value) MustStringMapInt64() map[string]int64 {
+	value, err := ToStringMapInt64E(v.value)
+	return conversionMust(v, "map[string]int64", value, err)
+}
+
+// StringMapFloat64E ...
+func (v Value) StringMapFloat64E() (map[string]float64, error) {
+	value, err := ToStringMapFloat64E(v.value)
+	return conversionE(v, "map[string]float64", value, err)
+}
+
+// StringMapFloat64Ok ...
+func (v Value) StringMapFloat64Ok() (map[string]float64, bool) {
+	value, err := ToStringMapFloat64E(v.value)
+	return conversionOk(v, value, err)
+}
+
+// StringMapFloat64Or ...
+func (v Value) StringMapFloat64Or(def map[string]float64) map[string]float64 {
+	value, err := ToStringMapFloat64E(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustStringMapFloat64 ...
+func (v Value) MustStringMapFloat64() map[string]float64 {
+	value, err := ToStringMapFloat64E(v.value)
+	return conversionMust(v, "map[string]float64", value, err)
+}
+
+// StringMapBoolE ...
+func (v Value) StringMapBoolE() (map[string]bool, error) {
+	value, err := ToStringMapBoolE(v.value)
+	return conversionE(v, "map[string]bool", value, err)
+}
+
+// StringMapBoolOk ...
+func (v Value) StringMapBoolOk() (map[string]bool, bool) {
+	value, err := ToStringMapBoolE(v.value)
+	return conversionOk(v, value, err)
+}
+
+// StringMapBoolOr ...
+func (v Value) StringMapBoolOr(def map[string]bool) map[string]bool {
+	value, err := ToStringMapBoolE(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustStringMapBool ...
+func (v Value) MustStringMapBool() map[string]bool {
+	value, err := ToStringMapBoolE(v.value)
+	return conversionMust(v, "map[string]bool", value, err)
+}
+
+// StringMapStringSliceE ...
+func (v Value) StringMapStringSliceE() (map[string][]string, error) {
+	value, err := ToStringMapStringSliceE(v.value)
+	return conversionE(v, "map[string][]string", value, err)
+}
+
+// StringMapStringSliceOk ...
+func (v Value) StringMapStringSliceOk() (map[string][]string, bool) {
+	value, err := ToStringMapStringSliceE(v.value)
+	return conversionOk(v, value, err)
+}
+
+// StringMapStringSliceOr ...
+func (v Value) StringMapStringSliceOr(def map[string][]string) map[string][]string {
+	value, err := ToStringMapStringSliceE(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustStringMapStringSlice ...
+func (v Value) MustStringMapStringSlice() map[string][]string {
+	value, err := ToStringMapStringSliceE(v.value)
+	return conversionMust(v, "map[string][]string", value, err)
+}
+
+// QueryMapE ...
+func (v Value) QueryMapE() (map[string]interface{}, error) {
+	value, err := ToQueryMapE(v.value)
+	return conversionE(v, "map[string]interface{}", value, err)
+}
+
+// QueryMapOk ...
+func (v Value) QueryMapOk() (map[string]interface{}, bool) {
+	value, err := ToQueryMapE(v.value)
+	return conversionOk(v, value, err)
+}
+
+// QueryMapOr ...
+func (v Value) QueryMapOr(def map[string]interface{}) map[string]interface{} {
+	value, err := ToQueryMapE(v.value)
+	return conversionOr(v, def, value, err)
+}
+
+// MustQueryMap ...
+func (v Value) MustQueryMap() map[string]interface{} {
+	value, err := ToQueryMapE(v.value)
+	return conversionMust(v, "map[string]interface{}", value, err)
+}
+
+// URLValuesE ...
+func (v Value) URLValuesE(style ...QueryStyle) (url.Values, error) {
+	value, err := ToURLValuesE(v.value, style...)
+	return conversionE(v, "url.Values", value, err)
+}
+
+// URLValuesOk ...
+func (v Value) URLValuesOk(style ...QueryStyle) (url.Values, bool) {
+	value, err := ToURLValuesE(v.value, style...)
+	return conversionOk(v, value, err)
+}
+
+// URLValuesOr ...
+func (v Value) URLValuesOr(def url.Values, style ...QueryStyle) url.Values {
+	value, err := ToURLValuesE(v.value, style...)
+	return conversionOr(v, def, value, err)
+}
+
+// MustURLValues ...
+func (v Value) MustURLValues(style ...QueryStyle) url.Values {
+	value, err := ToURLValuesE(v.value, style...)
+	return conversionMust(v, "url.Values", value, err)
+}